@@ -0,0 +1,163 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Argentine holidays
+//the movable holidays follow NearestMonday since Law 27.399 of 2017
+var (
+	MovableHolidaysAR = Effective{From: 2018}
+	GuemesDayAR       = Effective{From: 2016}
+	SovereigntyDayAR  = Effective{From: 2010}
+)
+
+//arBridgeDays lists the "feriados puente", the bridge days the government
+//declares a year ahead to join holidays to weekends
+//It covers 2018, the first year under Law 27.399, to 2026,
+//later years are added as their decrees are published
+var arBridgeDays = map[time.Time]string{
+	time.Date(2018, time.April, 30, 0, 0, 0, 0, time.UTC):    "Bridge Holiday",
+	time.Date(2018, time.December, 24, 0, 0, 0, 0, time.UTC): "Bridge Holiday",
+	time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC): "Bridge Holiday",
+	time.Date(2019, time.July, 8, 0, 0, 0, 0, time.UTC):      "Bridge Holiday",
+	time.Date(2019, time.August, 19, 0, 0, 0, 0, time.UTC):   "Bridge Holiday",
+	time.Date(2019, time.October, 14, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+	time.Date(2020, time.March, 23, 0, 0, 0, 0, time.UTC):    "Bridge Holiday",
+	time.Date(2020, time.July, 10, 0, 0, 0, 0, time.UTC):     "Bridge Holiday",
+	time.Date(2020, time.December, 7, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+	time.Date(2021, time.May, 24, 0, 0, 0, 0, time.UTC):      "Bridge Holiday",
+	time.Date(2021, time.October, 8, 0, 0, 0, 0, time.UTC):   "Bridge Holiday",
+	time.Date(2021, time.November, 22, 0, 0, 0, 0, time.UTC): "Bridge Holiday",
+	time.Date(2022, time.October, 7, 0, 0, 0, 0, time.UTC):   "Bridge Holiday",
+	time.Date(2022, time.November, 21, 0, 0, 0, 0, time.UTC): "Bridge Holiday",
+	time.Date(2022, time.December, 9, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+	time.Date(2023, time.May, 26, 0, 0, 0, 0, time.UTC):      "Bridge Holiday",
+	time.Date(2023, time.June, 19, 0, 0, 0, 0, time.UTC):     "Bridge Holiday",
+	time.Date(2023, time.October, 13, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+	time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC):     "Bridge Holiday",
+	time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC):     "Bridge Holiday",
+	time.Date(2024, time.October, 11, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+	time.Date(2025, time.May, 2, 0, 0, 0, 0, time.UTC):       "Bridge Holiday",
+	time.Date(2025, time.August, 15, 0, 0, 0, 0, time.UTC):   "Bridge Holiday",
+	time.Date(2025, time.November, 21, 0, 0, 0, 0, time.UTC): "Bridge Holiday",
+	time.Date(2026, time.March, 23, 0, 0, 0, 0, time.UTC):    "Bridge Holiday",
+	time.Date(2026, time.July, 10, 0, 0, 0, 0, time.UTC):     "Bridge Holiday",
+	time.Date(2026, time.December, 7, 0, 0, 0, 0, time.UTC):  "Bridge Holiday",
+}
+
+//arOneOffs lists the one-off national holidays
+var arOneOffs = map[time.Time]string{
+	time.Date(2022, time.May, 18, 0, 0, 0, 0, time.UTC):      "National Census",
+	time.Date(2022, time.December, 20, 0, 0, 0, 0, time.UTC): "World Cup Celebration",
+}
+
+//ARCal for Argentine national holidays and non-working days
+//has all BasicCal methods
+type ARCal struct {
+	BasicCal
+}
+
+//IsCarnival checks for Carnival Monday and Tuesday, 48 and 47 days before Easter
+func (cal ARCal) IsCarnival(y int, dd int) bool {
+	em := cal.EasterMonday(y)
+	return dd == em-49 || dd == em-48
+}
+
+//IsHolyThursday checks for Holy Thursday
+func (cal ARCal) IsHolyThursday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-4
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal ARCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsMovable checks for a movable holiday dated month hm and day hd,
+//observed on the nearest Monday since 2018
+func (cal ARCal) IsMovable(y int, m time.Month, d int, hm time.Month, hd int) bool {
+	if MovableHolidaysAR.Covers(y) {
+		return Observance(NearestMonday).ObservedOn(y, m, d, hm, hd)
+	}
+
+	return d == hd && m == hm
+}
+
+//IsSanMartinDay checks for the anniversary of General San Martín's death,
+//the third Monday of August before 2018
+func (cal ARCal) IsSanMartinDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MovableHolidaysAR.Covers(y) {
+		return cal.IsMovable(y, m, d, time.August, 17)
+	}
+
+	return (d >= 15 && d <= 21) && w == time.Monday && m == time.August
+}
+
+//HolidayName names Argentine holidays
+func (cal ARCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsCarnival(y, dd):
+		return "Carnival", true
+	case d == 24 && m == time.March:
+		return "Day of Remembrance for Truth and Justice", true
+	case d == 2 && m == time.April:
+		return "Malvinas Day", true
+	case cal.IsHolyThursday(y, dd):
+		return "Holy Thursday", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case d == 25 && m == time.May:
+		return "May Revolution Day", true
+	case cal.IsMovable(y, m, d, time.June, 17) && GuemesDayAR.Covers(y):
+		return "Martín Miguel de Güemes Day", true
+	case d == 20 && m == time.June:
+		return "Flag Day", true
+	case d == 9 && m == time.July:
+		return "Independence Day", true
+	case cal.IsSanMartinDay(y, m, d, w):
+		return "General San Martín Day", true
+	case cal.IsMovable(y, m, d, time.October, 12):
+		return "Day of Respect for Cultural Diversity", true
+	case cal.IsMovable(y, m, d, time.November, 20) && SovereigntyDayAR.Covers(y):
+		return "National Sovereignty Day", true
+	case d == 8 && m == time.December:
+		return "Immaculate Conception", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	}
+
+	if name, ok := arBridgeDays[dateKey(t)]; ok {
+		return name, true
+	}
+	if name, ok := arOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//BYMACal, calendar for Bolsas y Mercados Argentinos
+//has all ARCal methods
+//It also satisfies BizCal interface
+type BYMACal struct {
+	ARCal
+}
+
+//IsBusinessDay checks for business day according to BYMA Calendar
+func (cal BYMACal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
@@ -0,0 +1,47 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestARHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Carnival 48 and 47 days before Easter, March 31st 2024
+		{time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2024, time.February, 13, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2024, time.March, 28, 0, 0, 0, 0, time.UTC), "Holy Thursday"},
+		// nearest Monday, from Tuesday June 17th and Wednesday November 20th
+		// back to Monday, from Thursday November 20th 2025 on to Monday,
+		// Saturdays and Sundays stay
+		{time.Date(2025, time.June, 16, 0, 0, 0, 0, time.UTC), "Martín Miguel de Güemes Day"},
+		{time.Date(2025, time.June, 17, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.November, 18, 0, 0, 0, 0, time.UTC), "National Sovereignty Day"},
+		{time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2025, time.November, 24, 0, 0, 0, 0, time.UTC), "National Sovereignty Day"},
+		{time.Date(2024, time.August, 17, 0, 0, 0, 0, time.UTC), "General San Martín Day"},
+		{time.Date(2024, time.October, 12, 0, 0, 0, 0, time.UTC), "Day of Respect for Cultural Diversity"},
+		// the third Monday of August before Law 27.399
+		{time.Date(2017, time.August, 21, 0, 0, 0, 0, time.UTC), "General San Martín Day"},
+		{time.Date(2015, time.June, 17, 0, 0, 0, 0, time.UTC), ""},
+		// bridge days and one-offs
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), "Bridge Holiday"},
+		{time.Date(2024, time.October, 11, 0, 0, 0, 0, time.UTC), "Bridge Holiday"},
+		{time.Date(2025, time.November, 21, 0, 0, 0, 0, time.UTC), "Bridge Holiday"},
+		{time.Date(2022, time.May, 18, 0, 0, 0, 0, time.UTC), "National Census"},
+		{time.Date(2022, time.December, 20, 0, 0, 0, 0, time.UTC), "World Cup Celebration"},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (ARCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("AR %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+
+	if (BYMACal{}).IsBusinessDay(time.Date(2022, time.December, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("BYMA open on 2022-12-20, want closed")
+	}
+}
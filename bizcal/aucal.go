@@ -0,0 +1,300 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Australian holidays
+var (
+	AustraliaDayJan26   = Effective{From: 1994}
+	KingsBirthdayAU     = Effective{From: 2023}
+	LabourDayOctoberQLD = Effective{From: 2013, To: 2015}
+	KingsBirthdayOctQLD = Effective{From: 2016}
+)
+
+//auOneOffs lists the one-off national holidays
+var auOneOffs = map[time.Time]string{
+	time.Date(2022, time.September, 22, 0, 0, 0, 0, time.UTC): "National Day of Mourning for Queen Elizabeth II",
+}
+
+//auGrandFinalFriday lists the Fridays before the AFL Grand Final,
+//a Victorian holiday, as month and day by year
+//The day is gazetted once the AFL fixes the Grand Final date,
+//a year is added here when it is gazetted, other years have no holiday
+//and years after the last one are outside the YearRange of MelbourneCal
+var auGrandFinalFriday = map[int][2]int{
+	2015: {10, 2}, 2016: {9, 30}, 2017: {9, 29}, 2018: {9, 28}, 2019: {9, 27},
+	2020: {10, 23}, 2021: {9, 24}, 2022: {9, 23}, 2023: {9, 29}, 2024: {9, 27},
+	2025: {9, 26}, 2026: {9, 25},
+}
+
+//auKingsBirthdayWA lists the proclaimed King's Birthday of Western Australia
+//as month and day by year, other years have the last Monday of September
+//It only answers IsKingsBirthday for "WA", no registered calendar follows the state
+var auKingsBirthdayWA = map[int][2]int{
+	2020: {9, 28}, 2021: {9, 27}, 2022: {9, 26}, 2023: {9, 25}, 2024: {9, 23},
+	2025: {9, 29}, 2026: {9, 28},
+}
+
+//AUCal for Australian holidays, state rules take the state's abbreviation
+//has all BasicCal methods
+type AUCal struct {
+	BasicCal
+}
+
+//IsNewYearsDay checks for New Year's Day
+func (cal AUCal) IsNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// January 1st, possibly moved to Monday
+	return (d == 1 || ((d == 2 || d == 3) && w == time.Monday)) && m == time.January
+}
+
+//IsAustraliaDay checks for Australia Day
+func (cal AUCal) IsAustraliaDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !AustraliaDayJan26.Covers(y) {
+		// the Monday on or after January 26th
+		return w == time.Monday && ((d >= 26 && m == time.January) || (d == 1 && m == time.February))
+	}
+
+	// January 26th, possibly moved to Monday
+	return (d == 26 || ((d == 27 || d == 28) && w == time.Monday)) && m == time.January
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal AUCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsEasterMonday checks for Easter Monday
+func (cal AUCal) IsEasterMonday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)
+}
+
+//IsAnzacDay checks for Anzac Day, not moved off the weekend in NSW and Victoria
+func (cal AUCal) IsAnzacDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 25 && m == time.April
+}
+
+//IsKingsBirthday checks for the King's, before 2023 the Queen's, Birthday of a state
+func (cal AUCal) IsKingsBirthday(state string, y int, m time.Month, d int, w time.Weekday) bool {
+	switch {
+	case state == "QLD" && y == 2012 && d <= 7 && w == time.Monday && m == time.October:
+		// the Diamond Jubilee, on top of the June holiday
+		return true
+	case state == "QLD" && KingsBirthdayOctQLD.Covers(y):
+		// first Monday of October
+		return d <= 7 && w == time.Monday && m == time.October
+	case state == "WA":
+		if md, ok := auKingsBirthdayWA[y]; ok {
+			return m == time.Month(md[0]) && d == md[1]
+		}
+		// last Monday of September
+		return d >= 24 && w == time.Monday && m == time.September
+	}
+
+	// second Monday of June
+	return (d >= 8 && d <= 14) && w == time.Monday && m == time.June
+}
+
+//kingsBirthdayName names the sovereign's birthday holiday of a year
+func (cal AUCal) kingsBirthdayName(y int) string {
+	if KingsBirthdayAU.Covers(y) {
+		return "King's Birthday"
+	}
+
+	return "Queen's Birthday"
+}
+
+//IsLabourDay checks for Labour Day of a state, Eight Hours Day in Tasmania
+//and May Day in the Northern Territory
+func (cal AUCal) IsLabourDay(state string, y int, m time.Month, d int, w time.Weekday) bool {
+	switch state {
+	case "VIC", "TAS":
+		// second Monday of March
+		return (d >= 8 && d <= 14) && w == time.Monday && m == time.March
+	case "WA":
+		// first Monday of March
+		return d <= 7 && w == time.Monday && m == time.March
+	case "QLD", "NT":
+		if state == "QLD" && LabourDayOctoberQLD.Covers(y) {
+			return d <= 7 && w == time.Monday && m == time.October
+		}
+		// first Monday of May
+		return d <= 7 && w == time.Monday && m == time.May
+	}
+
+	// first Monday of October, NSW, ACT and SA
+	return d <= 7 && w == time.Monday && m == time.October
+}
+
+//IsBankHolidayNSW checks for the New South Wales bank holiday
+func (cal AUCal) IsBankHolidayNSW(y int, m time.Month, d int, w time.Weekday) bool {
+	// first Monday of August
+	return d <= 7 && w == time.Monday && m == time.August
+}
+
+//IsMelbourneCupDay checks for Melbourne Cup Day
+func (cal AUCal) IsMelbourneCupDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// first Tuesday of November
+	return d <= 7 && w == time.Tuesday && m == time.November
+}
+
+//IsGrandFinalFriday checks for the Friday before the AFL Grand Final
+func (cal AUCal) IsGrandFinalFriday(y int, m time.Month, d int, w time.Weekday) bool {
+	md, ok := auGrandFinalFriday[y]
+	return ok && m == time.Month(md[0]) && d == md[1]
+}
+
+//IsChristmas checks for Christmas
+func (cal AUCal) IsChristmas(y int, m time.Month, d int, w time.Weekday) bool {
+	// Christmas (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 25 || (d == 27 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsBoxingDay checks for Boxing Day
+func (cal AUCal) IsBoxingDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Boxing Day (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 26 || (d == 28 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//HolidayName names the national holidays and the King's Birthday of New South Wales
+func (cal AUCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsAustraliaDay(y, m, d, w):
+		return "Australia Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsAnzacDay(y, m, d, w):
+		return "Anzac Day", true
+	case cal.IsKingsBirthday("NSW", y, m, d, w):
+		return cal.kingsBirthdayName(y), true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsBoxingDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	if name, ok := auOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//ASXCal, calendar for the Australian Securities Exchange
+//has all AUCal methods
+//It also satisfies BizCal interface
+type ASXCal struct {
+	AUCal
+}
+
+//IsBusinessDay checks for business day according to ASX Calendar
+func (cal ASXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//EarlyClose returns the 14:10 close on Christmas Eve and New Year's Eve
+func (cal ASXCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	if m == time.December && (d == 24 || d == 31) {
+		return closeAt(t, 14, 10, Sydney), true
+	}
+
+	return time.Time{}, false
+}
+
+//SydneyCal, calendar for Sydney banking days
+//has all AUCal methods
+//It also satisfies BizCal interface
+type SydneyCal struct {
+	AUCal
+}
+
+//IsBusinessDay checks for business day according to Sydney Calendar
+func (cal SydneyCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names New South Wales bank holidays
+func (cal SydneyCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case cal.IsLabourDay("NSW", y, m, d, w):
+		return "Labour Day", true
+	case cal.IsBankHolidayNSW(y, m, d, w):
+		return "Bank Holiday", true
+	}
+
+	return cal.AUCal.HolidayName(t)
+}
+
+//MelbourneCal, calendar for Melbourne banking days
+//has all AUCal methods
+//It also satisfies BizCal interface
+type MelbourneCal struct {
+	AUCal
+}
+
+//IsBusinessDay checks for business day according to Melbourne Calendar
+func (cal MelbourneCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//YearRange returns the years up to the last gazetted Grand Final Friday
+func (cal MelbourneCal) YearRange() (from, to int) {
+	from, to = MinYear, MinYear
+	for y := range auGrandFinalFriday {
+		if y > to {
+			to = y
+		}
+	}
+
+	return from, to
+}
+
+//HolidayName names Victorian public holidays
+func (cal MelbourneCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case cal.IsLabourDay("VIC", y, m, d, w):
+		return "Labour Day", true
+	case cal.IsGrandFinalFriday(y, m, d, w):
+		return "Friday before the AFL Grand Final", true
+	case cal.IsMelbourneCupDay(y, m, d, w):
+		return "Melbourne Cup Day", true
+	}
+
+	return cal.AUCal.HolidayName(t)
+}
@@ -0,0 +1,65 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueenslandBirthday2012(t *testing.T) {
+	// Queensland kept the June holiday in 2012 and added the Diamond Jubilee in October
+	for _, day := range []time.Time{
+		time.Date(2012, time.June, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2012, time.October, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2016, time.October, 3, 0, 0, 0, 0, time.UTC),
+	} {
+		y, m, d := day.Date()
+		if !(AUCal{}).IsKingsBirthday("QLD", y, m, d, day.Weekday()) {
+			t.Errorf("%s is not a Queensland holiday, want the sovereign's birthday", day.Format("2006-01-02"))
+		}
+	}
+	if (AUCal{}).IsKingsBirthday("QLD", 2016, time.June, 13, time.Monday) {
+		t.Errorf("2016-06-13 is a Queensland holiday, want none since the move to October")
+	}
+}
+
+func TestAUSubstituteDays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Australia Day on a Sunday moves to Monday
+		{time.Date(2025, time.January, 27, 0, 0, 0, 0, time.UTC), "Australia Day"},
+		{time.Date(2026, time.January, 26, 0, 0, 0, 0, time.UTC), "Australia Day"},
+		// the Monday on or after January 26th before 1994
+		{time.Date(1993, time.February, 1, 0, 0, 0, 0, time.UTC), "Australia Day"},
+		{time.Date(1993, time.January, 26, 0, 0, 0, 0, time.UTC), ""},
+		// Christmas on a Sunday moves past Boxing Day
+		{time.Date(2022, time.December, 26, 0, 0, 0, 0, time.UTC), "Boxing Day"},
+		{time.Date(2022, time.December, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		// Christmas and Boxing Day on the weekend
+		{time.Date(2021, time.December, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		{time.Date(2021, time.December, 28, 0, 0, 0, 0, time.UTC), "Boxing Day"},
+		// Anzac Day is not moved off the weekend in New South Wales
+		{time.Date(2021, time.April, 26, 0, 0, 0, 0, time.UTC), ""},
+	}
+	for _, tt := range tests {
+		if name, _ := (ASXCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("ASXCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestMelbourneGrandFinalFriday(t *testing.T) {
+	if name, _ := (MelbourneCal{}).HolidayName(time.Date(2026, time.September, 25, 0, 0, 0, 0, time.UTC)); name != "Friday before the AFL Grand Final" {
+		t.Errorf("MelbourneCal 2026-09-25 is %q, want Friday before the AFL Grand Final", name)
+	}
+	if _, to := YearRange(MelbourneCal{}); to != 2026 {
+		t.Errorf("YearRange(MelbourneCal) ends in %d, want 2026", to)
+	}
+	if err := CheckYear(MelbourneCal{}, 2027); err == nil {
+		t.Errorf("CheckYear(MelbourneCal, 2027) succeeded, want an error")
+	}
+	if err := CheckYear(ASXCal{}, 2027); err != nil {
+		t.Errorf("CheckYear(ASXCal, 2027) = %v, want no error", err)
+	}
+}
@@ -1,6 +1,7 @@
 package bizcal
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -140,4 +141,127 @@ func (cal BasicCal) OrthodoxEasterMonday(year int) int {
 	return easter[year-1901]
 }
 
-// End QuantLib code adaptation
+//End QuantLib code adaptation
+
+//Effective is the span of years a holiday rule is in force, both ends inclusive
+//a zero bound leaves that end open
+type Effective struct {
+	From int
+	To   int
+}
+
+//Covers checks if a rule with this span is in force in a particular year
+func (e Effective) Covers(year int) bool {
+	return (e.From == 0 || year >= e.From) && (e.To == 0 || year <= e.To)
+}
+
+//Observance moves a holiday from its date to the day it is observed on
+type Observance func(t time.Time) time.Time
+
+//NextMonday observes a holiday on the Monday on or after its date,
+//the policy of Colombia's Ley Emiliani
+func NextMonday(t time.Time) time.Time {
+	return t.AddDate(0, 0, (8-int(t.Weekday()))%7)
+}
+
+//NearestMonday observes a holiday on a Tuesday or Wednesday on the Monday before,
+//and one on a Thursday or Friday on the Monday after,
+//the policy of Argentina's Law 27.399 for its movable holidays
+func NearestMonday(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Tuesday, time.Wednesday:
+		return t.AddDate(0, 0, 1-int(t.Weekday()))
+	case time.Thursday, time.Friday:
+		return t.AddDate(0, 0, 8-int(t.Weekday()))
+	}
+
+	return t
+}
+
+//WeekMonday observes a holiday on a Tuesday, Wednesday or Thursday on the Monday before,
+//and one on a Friday on the Monday after,
+//the policy of Chile's Law 19.668 for its movable holidays
+func WeekMonday(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Tuesday, time.Wednesday, time.Thursday:
+		return t.AddDate(0, 0, 1-int(t.Weekday()))
+	case time.Friday:
+		return t.AddDate(0, 0, 3)
+	}
+
+	return t
+}
+
+//ObservedOn checks if the holiday dated month hm and day hd of year y
+//is observed on month m and day d
+func (o Observance) ObservedOn(y int, m time.Month, d int, hm time.Month, hd int) bool {
+	t := o(time.Date(y, hm, hd, 0, 0, 0, 0, time.UTC))
+	return t.Month() == m && t.Day() == d
+}
+
+//MinYear and MaxYear bound the Easter table of BasicCal,
+//and with it the years the calendars are computed for
+const (
+	MinYear = 1901
+	MaxYear = 2199
+)
+
+//YearRanger is implemented by calendars built on published tables
+//that cover a narrower span of years than MinYear to MaxYear
+type YearRanger interface {
+	YearRange() (from, to int)
+}
+
+//YearRange returns the years a calendar gives reliable answers for
+func YearRange(cal BizCal) (from, to int) {
+	if yr, ok := cal.(YearRanger); ok {
+		return yr.YearRange()
+	}
+
+	return MinYear, MaxYear
+}
+
+//CheckYear returns an error for a year outside the YearRange of a calendar
+func CheckYear(cal BizCal, year int) error {
+	from, to := YearRange(cal)
+	if year < from || year > to {
+		return fmt.Errorf("bizcal: year %d outside the supported years %d to %d", year, from, to)
+	}
+
+	return nil
+}
+
+//Bounded is a calendar for walks such as NextBusinessDay and AddBusinessDays,
+//days outside the YearRange of the calendar count as business days
+//so a walk stops there, Err tells whether it did
+type Bounded struct {
+	BizCal
+	From, To int
+	out      bool
+}
+
+//NewBounded bounds a calendar by its YearRange
+func NewBounded(cal BizCal) *Bounded {
+	from, to := YearRange(cal)
+	return &Bounded{BizCal: cal, From: from, To: to}
+}
+
+//IsBusinessDay reports days outside the supported years as business days
+//so the walk stops, and records that it left them
+func (b *Bounded) IsBusinessDay(t time.Time) bool {
+	if y := t.Year(); y < b.From || y > b.To {
+		b.out = true
+		return true
+	}
+
+	return b.BizCal.IsBusinessDay(t)
+}
+
+//Err returns an error once a walk has left the supported years
+func (b *Bounded) Err() error {
+	if b.out {
+		return fmt.Errorf("bizcal: result outside the supported years %d to %d", b.From, b.To)
+	}
+
+	return nil
+}
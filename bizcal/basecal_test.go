@@ -0,0 +1,25 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBounded(t *testing.T) {
+	// the walk stops at the end of the festival table
+	b := NewBounded(SGXCal{})
+	if got := AddBusinessDays(b, time.Date(2026, time.December, 30, 0, 0, 0, 0, time.UTC), 3); got.Year() != 2027 {
+		t.Errorf("bounded walk ended on %s, want the first day of 2027", got.Format("2006-01-02"))
+	}
+	if b.Err() == nil {
+		t.Errorf("bounded walk into 2027 has no error, want one")
+	}
+
+	b = NewBounded(SGXCal{})
+	if got := NextBusinessDay(b, time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)); !got.Equal(time.Date(2025, time.December, 26, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bounded NextBusinessDay = %s, want 2025-12-26", got.Format("2006-01-02"))
+	}
+	if err := b.Err(); err != nil {
+		t.Errorf("bounded walk within the years: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Brazilian holidays
+var (
+	BlackConsciousnessBR = Effective{From: 2024}
+	SaoPauloHolidaysB3   = Effective{To: 2021}
+)
+
+//BRCal for Brazilian national holidays
+//has all BasicCal methods
+type BRCal struct {
+	BasicCal
+}
+
+//IsCarnival checks for Carnival Monday and Tuesday, 48 and 47 days before Easter
+func (cal BRCal) IsCarnival(y int, dd int) bool {
+	em := cal.EasterMonday(y)
+	return dd == em-49 || dd == em-48
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal BRCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsCorpusChristi checks for Corpus Christi, 60 days after Easter
+func (cal BRCal) IsCorpusChristi(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)+59
+}
+
+//IsBlackConsciousnessDay checks for Black Consciousness Day, national since 2024
+func (cal BRCal) IsBlackConsciousnessDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 20 && m == time.November && BlackConsciousnessBR.Covers(y)
+}
+
+//HolidayName names Brazilian national holidays
+func (cal BRCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsCarnival(y, dd):
+		return "Carnival", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case d == 21 && m == time.April:
+		return "Tiradentes", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case cal.IsCorpusChristi(y, dd):
+		return "Corpus Christi", true
+	case d == 7 && m == time.September:
+		return "Independence Day", true
+	case d == 12 && m == time.October:
+		return "Our Lady of Aparecida", true
+	case d == 2 && m == time.November:
+		return "All Souls' Day", true
+	case d == 15 && m == time.November:
+		return "Republic Proclamation Day", true
+	case cal.IsBlackConsciousnessDay(y, m, d, w):
+		return "Black Consciousness Day", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	}
+
+	return "", false
+}
+
+//ANBIMACal, the ANBIMA national calendar used for Bus/252 accruals
+//has all BRCal methods
+//It also satisfies BizCal interface
+type ANBIMACal struct {
+	BRCal
+}
+
+//IsBusinessDay checks for business day according to ANBIMA Calendar
+func (cal ANBIMACal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//B3Cal, calendar for B3, the Brazilian exchange in São Paulo
+//The exchange also closes on Christmas Eve and New Year's Eve,
+//and on the São Paulo city and state holidays until 2021
+//has all BRCal methods
+//It also satisfies BizCal interface
+type B3Cal struct {
+	BRCal
+}
+
+//IsBusinessDay checks for business day according to B3 Calendar
+func (cal B3Cal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names B3 holidays
+func (cal B3Cal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+
+	switch {
+	case d == 24 && m == time.December:
+		return "Christmas Eve", true
+	case d == 31 && m == time.December:
+		return "New Year's Eve", true
+	case d == 25 && m == time.January && SaoPauloHolidaysB3.Covers(y):
+		return "São Paulo Anniversary", true
+	case d == 9 && m == time.July && SaoPauloHolidaysB3.Covers(y):
+		return "Constitutionalist Revolution Day", true
+	case d == 20 && m == time.November && SaoPauloHolidaysB3.Covers(y):
+		return "Black Consciousness Day", true
+	}
+
+	return cal.BRCal.HolidayName(t)
+}
+
+//Bus252 is the Brazilian Bus/252 year fraction, the business days
+//from one date up to but not including another over 252,
+//with ANBIMACal as the calendar for Brazilian fixed income
+func Bus252(cal BizCal, from, to time.Time) float64 {
+	return float64(BusinessDaysBetween(cal, from, to)) / 252
+}
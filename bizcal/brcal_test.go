@@ -0,0 +1,32 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBRHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Carnival 48 and 47 days before Easter, Corpus Christi 60 days after
+		{time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2024, time.February, 13, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC), "Good Friday"},
+		{time.Date(2024, time.May, 30, 0, 0, 0, 0, time.UTC), "Corpus Christi"},
+		{time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC), "Carnival"},
+		{time.Date(2025, time.June, 19, 0, 0, 0, 0, time.UTC), "Corpus Christi"},
+		// Black Consciousness Day is national since 2024
+		{time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC), "Black Consciousness Day"},
+		{time.Date(2023, time.November, 20, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (BRCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("BR %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
@@ -74,6 +74,7 @@ func (cal CACal) IsCanadaDay(y int, m time.Month, d int, w time.Weekday) bool {
 }
 
 //IsProvincialHoliday checks for provincial holiday
+//It is not a national holiday, Canadian settlement and TSX close for it
 func (cal CACal) IsProvincialHoliday(y int, m time.Month, d int, w time.Weekday) bool {
 	// first Monday of August (Provincial Holiday)
 	return (d <= 7 && w == time.Monday && m == time.August)
@@ -111,6 +112,40 @@ func (cal CACal) IsBoxingDay(y int, m time.Month, d int, w time.Weekday) bool {
 		(d == 26 || (d == 28 && (w == time.Monday || w == time.Tuesday))))
 }
 
+//HolidayName names the Canadian holiday rule a day falls on
+//It does not check whether a particular calendar observes it
+func (cal CACal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsFamilyDay(y, m, d, w):
+		return "Family Day", true
+	case cal.IsGoodFriday(y, t.YearDay()):
+		return "Good Friday", true
+	case cal.IsVictoriaDay(y, m, d, w):
+		return "Victoria Day", true
+	case cal.IsCanadaDay(y, m, d, w):
+		return "Canada Day", true
+	case cal.IsLaborDay(y, m, d, w):
+		return "Labour Day", true
+	case TruthAndReconciliationFederal.Covers(y) && cal.IsTruthAndReconciliationDay(y, m, d, w):
+		return "National Day for Truth and Reconciliation", true
+	case cal.IsThanksgiving(y, m, d, w):
+		return "Thanksgiving Day", true
+	case cal.IsRememberanceDay(y, m, d, w):
+		return "Remembrance Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsBoxingDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	return "", false
+}
+
 //CASettleCal, calendar for CA Settlement
 //has all CACal methods
 //It also satisfies BizCal interface
@@ -118,7 +153,7 @@ type CASettleCal struct {
 	CACal
 }
 
-//IsBusinessDay checks for business day according to US Settlement Calendar
+//IsBusinessDay checks for business day according to CA Settlement Calendar
 func (cal CASettleCal) IsBusinessDay(t time.Time) bool {
 	if cal.IsWeekend(t) {
 		return false
@@ -135,6 +170,7 @@ func (cal CASettleCal) IsBusinessDay(t time.Time) bool {
 		cal.IsCanadaDay(y, m, d, w) ||
 		cal.IsProvincialHoliday(y, m, d, w) ||
 		cal.IsLaborDay(y, m, d, w) ||
+		(TruthAndReconciliationFederal.Covers(y) && cal.IsTruthAndReconciliationDay(y, m, d, w)) ||
 		cal.IsThanksgiving(y, m, d, w) ||
 		cal.IsRememberanceDay(y, m, d, w) ||
 		cal.IsChristmas(y, m, d, w) ||
@@ -146,9 +182,36 @@ func (cal CASettleCal) IsBusinessDay(t time.Time) bool {
 	return true
 }
 
+//HolidayName names CA Settlement holidays
+func (cal CASettleCal) HolidayName(t time.Time) (string, bool) {
+	if cal.IsBusinessDay(t) || cal.IsWeekend(t) {
+		return "", false
+	}
+
+	y, m, d := t.Date()
+	if cal.IsProvincialHoliday(y, m, d, t.Weekday()) {
+		return "Civic Holiday", true
+	}
+
+	return cal.CACal.HolidayName(t)
+}
+
+//tsxSpecialClosings lists the days the Toronto Stock Exchange closed
+//outside its holiday schedule
+//It stayed open on the national day of mourning for Queen Elizabeth II, 2022-09-19
+var tsxSpecialClosings = map[time.Time]string{
+	// trading halted shortly after the open
+	time.Date(2001, time.September, 11, 0, 0, 0, 0, time.UTC): "September 11 Attacks",
+	// the Northeast blackout of the evening before
+	time.Date(2003, time.August, 15, 0, 0, 0, 0, time.UTC): "Northeast Blackout",
+}
+
 //TSXCal, calendar for Toronto Stock Exchange
 //has all CACal methods
 //It also satisfies BizCal interface
+//Unlike Canadian settlement the exchange stays open on Remembrance Day
+//and the National Day for Truth and Reconciliation,
+//Christmas and Boxing Day move to the next weekdays the same way
 type TSXCal struct {
 	CACal
 }
@@ -171,12 +234,45 @@ func (cal TSXCal) IsBusinessDay(t time.Time) bool {
 		cal.IsProvincialHoliday(y, m, d, w) ||
 		cal.IsLaborDay(y, m, d, w) ||
 		cal.IsThanksgiving(y, m, d, w) ||
-		cal.IsRememberanceDay(y, m, d, w) ||
 		cal.IsChristmas(y, m, d, w) ||
 		cal.IsBoxingDay(y, m, d, w) {
 		// holidays
 		return false
 	}
 
-	return true
+	_, ok := tsxSpecialClosings[dateKey(t)]
+	return !ok
+}
+
+//HolidayName names TSX holidays and special closings
+func (cal TSXCal) HolidayName(t time.Time) (string, bool) {
+	if name, ok := tsxSpecialClosings[dateKey(t)]; ok {
+		return name, true
+	}
+
+	if cal.IsBusinessDay(t) || cal.IsWeekend(t) {
+		return "", false
+	}
+
+	y, m, d := t.Date()
+	if cal.IsProvincialHoliday(y, m, d, t.Weekday()) {
+		return "Civic Holiday", true
+	}
+
+	return cal.CACal.HolidayName(t)
+}
+
+//EarlyClose returns the close time in Toronto when the exchange
+//closes early, at 1:00 p.m. on Christmas Eve and New Year's Eve
+func (cal TSXCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	if m == time.December && (d == 24 || d == 31) {
+		return closeAt(t, 13, 0, Toronto), true
+	}
+
+	return time.Time{}, false
 }
@@ -0,0 +1,49 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTSXDiffersFromCASettle(t *testing.T) {
+	// the days TSX and Canadian settlement disagree on, TSX stays open
+	// on Remembrance Day and the National Day for Truth and Reconciliation
+	// and closed on its special closings, Christmas and Boxing Day agree
+	spans := []struct {
+		from, to time.Time
+		want     []string
+	}{
+		{time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, time.December, 31, 0, 0, 0, 0, time.UTC),
+			[]string{"2001-09-11", "2001-11-12", "2002-11-11", "2003-08-15", "2003-11-11"}},
+		{time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+			[]string{"2020-11-11", "2021-09-30", "2021-11-11", "2022-09-30", "2022-11-11",
+				"2023-10-02", "2023-11-13", "2024-09-30", "2024-11-11", "2025-09-30", "2025-11-11"}},
+	}
+
+	tsx, settle := TSXCal{}, CASettleCal{}
+	for _, sp := range spans {
+		var got []string
+		for day := sp.from; !day.After(sp.to); day = day.AddDate(0, 0, 1) {
+			if tsx.IsBusinessDay(day) != settle.IsBusinessDay(day) {
+				got = append(got, day.Format("2006-01-02"))
+			}
+		}
+		if len(got) != len(sp.want) {
+			t.Errorf("TSX and CASettle differ on %v, want %v", got, sp.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != sp.want[i] {
+				t.Errorf("TSX and CASettle differ on %v, want %v", got, sp.want)
+				break
+			}
+		}
+	}
+
+	if name, ok := tsx.HolidayName(time.Date(2021, time.November, 11, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("TSX 2021-11-11 is %q, want open", name)
+	}
+	if name, _ := tsx.HolidayName(time.Date(2003, time.August, 15, 0, 0, 0, 0, time.UTC)); name != "Northeast Blackout" {
+		t.Errorf("TSX 2003-08-15 is %q, want Northeast Blackout", name)
+	}
+}
@@ -0,0 +1,274 @@
+package bizcal
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+//Effective years of Canadian federal and provincial holidays
+var (
+	TruthAndReconciliationFederal = Effective{From: 2021}
+	TruthAndReconciliationPE      = Effective{From: 2022}
+	TruthAndReconciliationBC      = Effective{From: 2023}
+	TruthAndReconciliationMB      = Effective{From: 2023}
+	FamilyDayAB                   = Effective{From: 1990}
+	FamilyDaySK                   = Effective{From: 2007}
+	FamilyDayON                   = Effective{From: 2008}
+	FamilyDayNB                   = Effective{From: 2018}
+	LouisRielDayMB                = Effective{From: 2008}
+	IslanderDayPE                 = Effective{From: 2009}
+	HeritageDayNS                 = Effective{From: 2015}
+)
+
+//CAProvinces maps province codes to province names
+var CAProvinces = map[string]string{
+	"AB": "Alberta",
+	"BC": "British Columbia",
+	"MB": "Manitoba",
+	"NB": "New Brunswick",
+	"NL": "Newfoundland and Labrador",
+	"NS": "Nova Scotia",
+	"ON": "Ontario",
+	"PE": "Prince Edward Island",
+	"QC": "Quebec",
+	"SK": "Saskatchewan",
+}
+
+func init() {
+	codes := make([]string, 0, len(CAProvinces))
+	for code := range CAProvinces {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		Register("CA-"+code, CAProvinceCal{Province: code})
+	}
+}
+
+//IsTruthAndReconciliationDay checks for National Day for Truth and Reconciliation
+//It does not check the year, callers check the Effective span
+func (cal CACal) IsTruthAndReconciliationDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// September 30th (possibly moved to Monday)
+	return (d == 30 && m == time.September) ||
+		((d == 1 || d == 2) && w == time.Monday && m == time.October)
+}
+
+//IsThirdMondayOfFebruary checks for the February holiday of most provinces,
+//called Family Day, Louis Riel Day, Islander Day or Heritage Day
+//It does not check the year, callers check the Effective span
+func (cal CACal) IsThirdMondayOfFebruary(y int, m time.Month, d int, w time.Weekday) bool {
+	return (d >= 15 && d <= 21) && w == time.Monday && m == time.February
+}
+
+//IsIslanderDay checks for Prince Edward Island Islander Day
+func (cal CACal) IsIslanderDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if y >= 2010 {
+		// third Monday of February
+		return cal.IsThirdMondayOfFebruary(y, m, d, w)
+	}
+
+	// second Monday of February, 2009
+	return IslanderDayPE.Covers(y) && (d >= 8 && d <= 14) && w == time.Monday && m == time.February
+}
+
+//IsFamilyDayBC checks for British Columbia Family Day
+func (cal CACal) IsFamilyDayBC(y int, m time.Month, d int, w time.Weekday) bool {
+	if y >= 2019 {
+		// third Monday of February
+		return cal.IsThirdMondayOfFebruary(y, m, d, w)
+	}
+
+	// second Monday of February, 2013 to 2018
+	return y >= 2013 && (d >= 8 && d <= 14) && w == time.Monday && m == time.February
+}
+
+//IsSaintJeanBaptisteDay checks for Quebec's Fete nationale
+func (cal CACal) IsSaintJeanBaptisteDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// June 24th, moved to Monday when it falls on a Sunday
+	return (d == 24 || (d == 25 && w == time.Monday)) && m == time.June
+}
+
+//IsCanadaDayQC checks for Canada Day under Quebec labour standards
+func (cal CACal) IsCanadaDayQC(y int, m time.Month, d int, w time.Weekday) bool {
+	// July 1st, moved to Monday only when it falls on a Sunday
+	return (d == 1 || (d == 2 && w == time.Monday)) && m == time.July
+}
+
+//CAProvinceCal, calendar of the general holidays of one Canadian province
+//under its employment standards legislation, keyed by province code
+//such as "ON" or "QC", see CAProvinces
+//has all CACal methods
+//It also satisfies BizCal interface
+type CAProvinceCal struct {
+	CACal
+	Province string
+}
+
+//IsBusinessDay checks for business day according to the province calendar
+func (cal CAProvinceCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names the provincial general holiday a day falls on
+//an unknown province only has the holidays common to every province
+func (cal CAProvinceCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	// general holidays in every province
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsLaborDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	}
+
+	canadaDay := cal.IsCanadaDay(y, m, d, w)
+	victoriaDay := cal.IsVictoriaDay(y, m, d, w)
+	thanksgiving := cal.IsThanksgiving(y, m, d, w)
+	remembranceDay := cal.IsRememberanceDay(y, m, d, w)
+	civicHoliday := cal.IsProvincialHoliday(y, m, d, w)
+	february := cal.IsThirdMondayOfFebruary(y, m, d, w)
+	truthDay := cal.IsTruthAndReconciliationDay(y, m, d, w)
+
+	switch strings.ToUpper(cal.Province) {
+	case "AB":
+		switch {
+		case FamilyDayAB.Covers(y) && february:
+			return "Family Day", true
+		case victoriaDay:
+			return "Victoria Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	case "BC":
+		switch {
+		case cal.IsFamilyDayBC(y, m, d, w):
+			return "Family Day", true
+		case victoriaDay:
+			return "Victoria Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case civicHoliday:
+			return "British Columbia Day", true
+		case TruthAndReconciliationBC.Covers(y) && truthDay:
+			return "National Day for Truth and Reconciliation", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	case "MB":
+		switch {
+		case LouisRielDayMB.Covers(y) && february:
+			return "Louis Riel Day", true
+		case victoriaDay:
+			return "Victoria Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case TruthAndReconciliationMB.Covers(y) && truthDay:
+			return "National Day for Truth and Reconciliation", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		}
+	case "NB":
+		switch {
+		case FamilyDayNB.Covers(y) && february:
+			return "Family Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case civicHoliday:
+			return "New Brunswick Day", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	case "NL":
+		switch {
+		case canadaDay:
+			return "Memorial Day", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	case "NS":
+		switch {
+		case HeritageDayNS.Covers(y) && february:
+			return "Heritage Day", true
+		case canadaDay:
+			return "Canada Day", true
+		}
+	case "ON":
+		switch {
+		case FamilyDayON.Covers(y) && february:
+			return "Family Day", true
+		case victoriaDay:
+			return "Victoria Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		case cal.IsBoxingDay(y, m, d, w):
+			return "Boxing Day", true
+		}
+	case "PE":
+		switch {
+		case cal.IsIslanderDay(y, m, d, w):
+			return "Islander Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case TruthAndReconciliationPE.Covers(y) && truthDay:
+			return "National Day for Truth and Reconciliation", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	case "QC":
+		switch {
+		case victoriaDay && y >= 2003:
+			return "National Patriots' Day", true
+		case victoriaDay:
+			return "Dollard des Ormeaux Day", true
+		case cal.IsSaintJeanBaptisteDay(y, m, d, w):
+			return "Saint-Jean-Baptiste Day", true
+		case cal.IsCanadaDayQC(y, m, d, w):
+			return "Canada Day", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		}
+	case "SK":
+		switch {
+		case FamilyDaySK.Covers(y) && february:
+			return "Family Day", true
+		case victoriaDay:
+			return "Victoria Day", true
+		case canadaDay:
+			return "Canada Day", true
+		case civicHoliday:
+			return "Saskatchewan Day", true
+		case thanksgiving:
+			return "Thanksgiving Day", true
+		case remembranceDay:
+			return "Remembrance Day", true
+		}
+	default:
+		if canadaDay {
+			return "Canada Day", true
+		}
+	}
+
+	return "", false
+}
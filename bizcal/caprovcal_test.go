@@ -0,0 +1,49 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIslanderDay(t *testing.T) {
+	// the second Monday of February in 2009, the third from 2010
+	pe := CAProvinceCal{Province: "PE"}
+	tests := []struct {
+		day  time.Time
+		want bool
+	}{
+		{time.Date(2008, time.February, 18, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2009, time.February, 9, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2009, time.February, 16, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2010, time.February, 8, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2010, time.February, 15, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, time.February, 19, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		name, ok := pe.HolidayName(tt.day)
+		if ok != tt.want || (ok && name != "Islander Day") {
+			t.Errorf("PE %s is %q, %v, want Islander Day %v", tt.day.Format("2006-01-02"), name, ok, tt.want)
+		}
+	}
+}
+
+func TestCivicHoliday(t *testing.T) {
+	// the first Monday of August is not a national holiday,
+	// settlement and TSX close for it and so do some provinces
+	day := time.Date(2024, time.August, 5, 0, 0, 0, 0, time.UTC)
+	if name, ok := (CACal{}).HolidayName(day); ok {
+		t.Errorf("CA 2024-08-05 is %q, want no national holiday", name)
+	}
+	if name, _ := (CASettleCal{}).HolidayName(day); name != "Civic Holiday" {
+		t.Errorf("CASettle 2024-08-05 is %q, want Civic Holiday", name)
+	}
+	if name, _ := (TSXCal{}).HolidayName(day); name != "Civic Holiday" {
+		t.Errorf("TSX 2024-08-05 is %q, want Civic Holiday", name)
+	}
+	for code, want := range map[string]bool{"BC": true, "NB": true, "SK": true, "ON": false, "QC": false} {
+		if _, ok := (CAProvinceCal{Province: code}).HolidayName(day); ok != want {
+			t.Errorf("%s 2024-08-05 holiday %v, want %v", code, ok, want)
+		}
+	}
+}
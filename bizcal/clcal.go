@@ -0,0 +1,151 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Chilean holidays
+var (
+	MovableHolidaysCL      = Effective{From: 2000}
+	OurLadyOfCarmelCL      = Effective{From: 2007}
+	FiestasPatriasMonCL    = Effective{From: 2007}
+	FiestasPatriasFriCL    = Effective{From: 2017}
+	ReformationDayCL       = Effective{From: 2008}
+	IndigenousPeoplesDayCL = Effective{From: 2022}
+)
+
+//clOneOffs lists the holidays fixed by law for a single year
+var clOneOffs = map[time.Time]string{
+	time.Date(2021, time.June, 21, 0, 0, 0, 0, time.UTC): "National Day of Indigenous Peoples",
+}
+
+//CLCal for Chilean banking holidays
+//has all BasicCal methods
+type CLCal struct {
+	BasicCal
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal CLCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsIndigenousPeoplesDay checks for the National Day of Indigenous Peoples,
+//the day of the June solstice in Santiago
+func (cal CLCal) IsIndigenousPeoplesDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !IndigenousPeoplesDayCL.Covers(y) {
+		return false
+	}
+
+	s := JuneSolstice(y).In(Santiago)
+	return m == s.Month() && d == s.Day()
+}
+
+//IsMovable checks for a movable holiday dated month hm and day hd,
+//observed on the Monday of its week, or the Monday after from a Friday, since 2000
+func (cal CLCal) IsMovable(y int, m time.Month, d int, hm time.Month, hd int) bool {
+	if MovableHolidaysCL.Covers(y) {
+		return Observance(WeekMonday).ObservedOn(y, m, d, hm, hd)
+	}
+
+	return d == hd && m == hm
+}
+
+//IsFiestasPatrias checks for Independence Day and Army Day, September 18th and 19th,
+//joined to the weekend by September 17th on a Monday or September 20th on a Friday
+func (cal CLCal) IsFiestasPatrias(y int, m time.Month, d int, w time.Weekday) bool {
+	if m != time.September {
+		return false
+	}
+
+	switch d {
+	case 17:
+		return w == time.Monday && FiestasPatriasMonCL.Covers(y)
+	case 18, 19:
+		return true
+	case 20:
+		return w == time.Friday && FiestasPatriasFriCL.Covers(y)
+	}
+
+	return false
+}
+
+//IsReformationDay checks for Reformation Day, October 31st,
+//moved to the Friday before from a Tuesday and to the Friday after from a Wednesday
+func (cal CLCal) IsReformationDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !ReformationDayCL.Covers(y) {
+		return false
+	}
+
+	switch time.Date(y, time.October, 31, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Tuesday:
+		return d == 27 && m == time.October
+	case time.Wednesday:
+		return d == 2 && m == time.November
+	}
+
+	return d == 31 && m == time.October
+}
+
+//HolidayName names Chilean banking holidays
+func (cal CLCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case d == 21 && m == time.May:
+		return "Navy Day", true
+	case cal.IsIndigenousPeoplesDay(y, m, d, w):
+		return "National Day of Indigenous Peoples", true
+	case cal.IsMovable(y, m, d, time.June, 29):
+		return "Saints Peter and Paul", true
+	case d == 16 && m == time.July && OurLadyOfCarmelCL.Covers(y):
+		return "Our Lady of Mount Carmel", true
+	case d == 15 && m == time.August:
+		return "Assumption Day", true
+	case cal.IsFiestasPatrias(y, m, d, w):
+		return "Fiestas Patrias", true
+	case cal.IsMovable(y, m, d, time.October, 12):
+		return "Meeting of Two Worlds Day", true
+	case cal.IsReformationDay(y, m, d, w):
+		return "Reformation Day", true
+	case d == 1 && m == time.November:
+		return "All Saints' Day", true
+	case d == 8 && m == time.December:
+		return "Immaculate Conception", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	case d == 31 && m == time.December:
+		return "Bank Holiday", true
+	}
+
+	if name, ok := clOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//SantiagoCal, calendar for the Santiago Stock Exchange
+//has all CLCal methods
+//It also satisfies BizCal interface
+type SantiagoCal struct {
+	CLCal
+}
+
+//IsBusinessDay checks for business day according to Santiago Calendar
+func (cal SantiagoCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
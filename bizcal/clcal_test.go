@@ -0,0 +1,37 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChileMovableHolidays(t *testing.T) {
+	// Law 19.668 moves a Tuesday to Thursday holiday back to the Monday
+	// and a Friday holiday forward to the Monday after
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2023, time.June, 26, 0, 0, 0, 0, time.UTC), "Saints Peter and Paul"},
+		{time.Date(2023, time.October, 9, 0, 0, 0, 0, time.UTC), "Meeting of Two Worlds Day"},
+		{time.Date(2028, time.June, 26, 0, 0, 0, 0, time.UTC), "Saints Peter and Paul"},
+		{time.Date(2028, time.October, 9, 0, 0, 0, 0, time.UTC), "Meeting of Two Worlds Day"},
+		{time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC), "Meeting of Two Worlds Day"},
+		{time.Date(2024, time.June, 29, 0, 0, 0, 0, time.UTC), "Saints Peter and Paul"},
+	}
+	for _, tt := range tests {
+		if name, _ := (CLCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("CLCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+
+	for _, day := range []time.Time{
+		time.Date(2023, time.June, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.July, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.October, 16, 0, 0, 0, 0, time.UTC),
+	} {
+		if !(SantiagoCal{}).IsBusinessDay(day) {
+			t.Errorf("SantiagoCal %s is closed, want open", day.Format("2006-01-02"))
+		}
+	}
+}
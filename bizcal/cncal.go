@@ -0,0 +1,366 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Chinese public holidays
+var (
+	LabourDayWeekCN        = Effective{From: 2000, To: 2007}
+	TraditionalFestivalsCN = Effective{From: 2008}
+	SpringFestivalEveCN    = Effective{From: 2008, To: 2013}
+	HolidayReform2025CN    = Effective{From: 2025}
+)
+
+//cnArrangement is the State Council holiday arrangement of a year,
+//the days off of each holiday as month and day spans,
+//and the weekend days worked in exchange
+type cnArrangement struct {
+	off  map[string][2][2]int
+	work [][2]int
+}
+
+//cnArrangements lists the published arrangements by year, one is added each
+//year when the State Council publishes it, usually in November,
+//other years fall back to the statutory holidays,
+//which the exchange calendars do not support, see YearRange
+var cnArrangements = map[int]cnArrangement{
+	2019: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 1}},
+			"Spring Festival":      {{2, 4}, {2, 10}},
+			"Qingming Festival":    {{4, 5}, {4, 7}},
+			"Labour Day":           {{5, 1}, {5, 4}},
+			"Dragon Boat Festival": {{6, 7}, {6, 9}},
+			"Mid-Autumn Festival":  {{9, 13}, {9, 15}},
+			"National Day":         {{10, 1}, {10, 7}},
+		},
+		work: [][2]int{{2, 2}, {2, 3}, {4, 28}, {5, 5}, {9, 29}, {10, 12}},
+	},
+	2020: {
+		// the Spring Festival was extended to February 2nd,
+		// which cancelled the working Saturday of February 1st
+		off: map[string][2][2]int{
+			"New Year's Day":                       {{1, 1}, {1, 1}},
+			"Spring Festival":                      {{1, 24}, {2, 2}},
+			"Qingming Festival":                    {{4, 4}, {4, 6}},
+			"Labour Day":                           {{5, 1}, {5, 5}},
+			"Dragon Boat Festival":                 {{6, 25}, {6, 27}},
+			"National Day and Mid-Autumn Festival": {{10, 1}, {10, 8}},
+		},
+		work: [][2]int{{1, 19}, {4, 26}, {5, 9}, {6, 28}, {9, 27}, {10, 10}},
+	},
+	2021: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 3}},
+			"Spring Festival":      {{2, 11}, {2, 17}},
+			"Qingming Festival":    {{4, 3}, {4, 5}},
+			"Labour Day":           {{5, 1}, {5, 5}},
+			"Dragon Boat Festival": {{6, 12}, {6, 14}},
+			"Mid-Autumn Festival":  {{9, 19}, {9, 21}},
+			"National Day":         {{10, 1}, {10, 7}},
+		},
+		work: [][2]int{{2, 7}, {2, 20}, {4, 25}, {5, 8}, {9, 18}, {9, 26}, {10, 9}},
+	},
+	2022: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 3}},
+			"Spring Festival":      {{1, 31}, {2, 6}},
+			"Qingming Festival":    {{4, 3}, {4, 5}},
+			"Labour Day":           {{4, 30}, {5, 4}},
+			"Dragon Boat Festival": {{6, 3}, {6, 5}},
+			"Mid-Autumn Festival":  {{9, 10}, {9, 12}},
+			"National Day":         {{10, 1}, {10, 7}},
+		},
+		work: [][2]int{{1, 29}, {1, 30}, {4, 2}, {4, 24}, {5, 7}, {10, 8}, {10, 9}},
+	},
+	2023: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 2}},
+			"Spring Festival":      {{1, 21}, {1, 27}},
+			"Qingming Festival":    {{4, 5}, {4, 5}},
+			"Labour Day":           {{4, 29}, {5, 3}},
+			"Dragon Boat Festival": {{6, 22}, {6, 24}},
+			"Mid-Autumn Festival":  {{9, 29}, {9, 29}},
+			"National Day":         {{9, 30}, {10, 6}},
+		},
+		work: [][2]int{{1, 28}, {1, 29}, {4, 23}, {5, 6}, {6, 25}, {10, 7}, {10, 8}},
+	},
+	2024: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 1}},
+			"Spring Festival":      {{2, 10}, {2, 17}},
+			"Qingming Festival":    {{4, 4}, {4, 6}},
+			"Labour Day":           {{5, 1}, {5, 5}},
+			"Dragon Boat Festival": {{6, 10}, {6, 10}},
+			"Mid-Autumn Festival":  {{9, 15}, {9, 17}},
+			"National Day":         {{10, 1}, {10, 7}},
+		},
+		work: [][2]int{{2, 4}, {2, 18}, {4, 7}, {4, 28}, {5, 11}, {9, 14}, {9, 29}, {10, 12}},
+	},
+	2025: {
+		off: map[string][2][2]int{
+			"New Year's Day":                       {{1, 1}, {1, 1}},
+			"Spring Festival":                      {{1, 28}, {2, 4}},
+			"Qingming Festival":                    {{4, 4}, {4, 6}},
+			"Labour Day":                           {{5, 1}, {5, 5}},
+			"Dragon Boat Festival":                 {{5, 31}, {6, 2}},
+			"National Day and Mid-Autumn Festival": {{10, 1}, {10, 8}},
+		},
+		work: [][2]int{{1, 26}, {2, 8}, {4, 27}, {9, 28}, {10, 11}},
+	},
+	2026: {
+		off: map[string][2][2]int{
+			"New Year's Day":       {{1, 1}, {1, 3}},
+			"Spring Festival":      {{2, 15}, {2, 23}},
+			"Qingming Festival":    {{4, 4}, {4, 6}},
+			"Labour Day":           {{5, 1}, {5, 5}},
+			"Dragon Boat Festival": {{6, 19}, {6, 21}},
+			"Mid-Autumn Festival":  {{9, 25}, {9, 27}},
+			"National Day":         {{10, 1}, {10, 7}},
+		},
+		work: [][2]int{{1, 4}, {2, 14}, {2, 28}, {5, 9}, {9, 20}, {10, 10}},
+	},
+}
+
+//cnExchangeClosings lists the days the stock exchanges closed
+//on top of the State Council arrangement
+var cnExchangeClosings = map[time.Time]string{
+	time.Date(2024, time.February, 9, 0, 0, 0, 0, time.UTC): "Spring Festival",
+}
+
+//CNCal for Chinese public holidays, lunar dates are reckoned in China time
+//has all BasicCal methods
+type CNCal struct {
+	BasicCal
+}
+
+//lunar returns the Gregorian day and month of a lunar date of a year,
+//ok is false when the lunar calendar does not cover the year
+func (cal CNCal) lunar(y int, month int, day int) (time.Month, int, bool) {
+	t, ok := LunarToSolar(LunarDate{Year: y, Month: month, Day: day}, ChinaTime)
+	return t.Month(), t.Day(), ok
+}
+
+//YearRange returns the years with a published arrangement, outside them
+//the statutory fallback misses the bridge days the State Council adds
+func (cal CNCal) YearRange() (from, to int) {
+	from, to = MaxYear, MinYear
+	for y := range cnArrangements {
+		if y < from {
+			from = y
+		}
+		if y > to {
+			to = y
+		}
+	}
+
+	return from, to
+}
+
+//IsSpringFestival checks for the statutory days of the Spring Festival,
+//the lunar New Year, on New Year's Eve from 2008 to 2013 and again since 2025
+func (cal CNCal) IsSpringFestival(y int, m time.Month, d int, w time.Weekday) bool {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	ny := LunarNewYear(y, ChinaTime)
+	if ny.IsZero() {
+		return false
+	}
+	days := int(t.Sub(ny).Hours() / 24)
+
+	switch {
+	case HolidayReform2025CN.Covers(y):
+		return days >= -1 && days <= 2
+	case SpringFestivalEveCN.Covers(y):
+		return days >= -1 && days <= 1
+	}
+
+	return days >= 0 && days <= 2
+}
+
+//IsQingmingFestival checks for the Qingming Festival,
+//the day the sun reaches 15 degrees
+func (cal CNCal) IsQingmingFestival(y int, m time.Month, d int, w time.Weekday) bool {
+	if !TraditionalFestivalsCN.Covers(y) {
+		return false
+	}
+
+	t := SolarTerm(y, 15).In(ChinaTime)
+	return m == t.Month() && d == t.Day()
+}
+
+//IsLabourDay checks for the statutory days of Labour Day
+func (cal CNCal) IsLabourDay(y int, m time.Month, d int, w time.Weekday) bool {
+	switch {
+	case LabourDayWeekCN.Covers(y):
+		return m == time.May && d <= 3
+	case HolidayReform2025CN.Covers(y):
+		return m == time.May && d <= 2
+	}
+
+	return m == time.May && d == 1
+}
+
+//IsDragonBoatFestival checks for the Dragon Boat Festival, the 5th of the 5th lunar month
+func (cal CNCal) IsDragonBoatFestival(y int, m time.Month, d int, w time.Weekday) bool {
+	if !TraditionalFestivalsCN.Covers(y) {
+		return false
+	}
+
+	lm, ld, ok := cal.lunar(y, 5, 5)
+	return ok && m == lm && d == ld
+}
+
+//IsMidAutumnFestival checks for the Mid-Autumn Festival, the 15th of the 8th lunar month
+func (cal CNCal) IsMidAutumnFestival(y int, m time.Month, d int, w time.Weekday) bool {
+	if !TraditionalFestivalsCN.Covers(y) {
+		return false
+	}
+
+	lm, ld, ok := cal.lunar(y, 8, 15)
+	return ok && m == lm && d == ld
+}
+
+//IsNationalDay checks for the statutory days of National Day
+func (cal CNCal) IsNationalDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.October && d <= 3
+}
+
+//arranged looks a day up in the arrangement of its year,
+//ok is false for years without a published arrangement
+func (cal CNCal) arranged(t time.Time) (name string, off bool, ok bool) {
+	y, m, d := t.Date()
+	a, ok := cnArrangements[y]
+	if !ok {
+		return "", false, false
+	}
+
+	md := int(m)*100 + d
+	for n, span := range a.off {
+		if md >= span[0][0]*100+span[0][1] && md <= span[1][0]*100+span[1][1] {
+			return n, true, true
+		}
+	}
+
+	return "", false, true
+}
+
+//IsWorkingWeekend checks for a Saturday or Sunday made a working day
+//by the State Council arrangement of the year
+func (cal CNCal) IsWorkingWeekend(t time.Time) bool {
+	y, m, d := t.Date()
+	for _, md := range cnArrangements[y].work {
+		if m == time.Month(md[0]) && d == md[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+//HolidayName names Chinese public holidays, the days off of the State Council
+//arrangement where one is published, the statutory holidays otherwise
+func (cal CNCal) HolidayName(t time.Time) (string, bool) {
+	if name, off, ok := cal.arranged(t); ok {
+		return name, off
+	}
+
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsSpringFestival(y, m, d, w):
+		return "Spring Festival", true
+	case cal.IsQingmingFestival(y, m, d, w):
+		return "Qingming Festival", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsDragonBoatFestival(y, m, d, w):
+		return "Dragon Boat Festival", true
+	case cal.IsMidAutumnFestival(y, m, d, w):
+		return "Mid-Autumn Festival", true
+	case cal.IsNationalDay(y, m, d, w):
+		return "National Day", true
+	}
+
+	return "", false
+}
+
+//SSECal, calendar for the Shanghai Stock Exchange
+//The exchange stays closed on working weekend days
+//has all CNCal methods
+//It also satisfies BizCal interface
+type SSECal struct {
+	CNCal
+}
+
+//IsBusinessDay checks for business day according to Shanghai Stock Exchange Calendar
+func (cal SSECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Chinese stock exchange holidays
+func (cal SSECal) HolidayName(t time.Time) (string, bool) {
+	if name, ok := cnExchangeClosings[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return cal.CNCal.HolidayName(t)
+}
+
+//SZSECal, calendar for the Shenzhen Stock Exchange, same holidays as Shanghai
+//has all CNCal methods
+//It also satisfies BizCal interface
+type SZSECal struct {
+	CNCal
+}
+
+//IsBusinessDay checks for business day according to Shenzhen Stock Exchange Calendar
+func (cal SZSECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Chinese stock exchange holidays
+func (cal SZSECal) HolidayName(t time.Time) (string, bool) {
+	return SSECal{cal.CNCal}.HolidayName(t)
+}
+
+//CFETSCal, calendar for the China interbank market run by CFETS
+//The interbank market opens on working weekend days
+//has all CNCal methods
+//It also satisfies BizCal interface
+type CFETSCal struct {
+	CNCal
+}
+
+//IsWeekend checks for a Saturday or Sunday that is not a working weekend day
+func (cal CFETSCal) IsWeekend(t time.Time) bool {
+	return cal.CNCal.IsWeekend(t) && !cal.IsWorkingWeekend(t)
+}
+
+//IsWeekday checks for a weekday or a working weekend day
+func (cal CFETSCal) IsWeekday(t time.Time) bool {
+	return !(cal.IsWeekend(t))
+}
+
+//IsBusinessDay checks for business day according to CFETS Calendar
+func (cal CFETSCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
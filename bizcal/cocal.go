@@ -0,0 +1,126 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//EmilianiCO is the span of Colombia's Ley Emiliani, which moves most
+//religious and civic holidays to the following Monday
+var EmilianiCO = Effective{From: 1984}
+
+//COCal for Colombian holidays
+//has all BasicCal methods
+type COCal struct {
+	BasicCal
+}
+
+//IsEmiliani checks for a holiday dated month hm and day hd,
+//observed on the Monday on or after it under the Ley Emiliani
+func (cal COCal) IsEmiliani(y int, m time.Month, d int, hm time.Month, hd int) bool {
+	if EmilianiCO.Covers(y) {
+		return Observance(NextMonday).ObservedOn(y, m, d, hm, hd)
+	}
+
+	return d == hd && m == hm
+}
+
+//IsHolyThursday checks for Holy Thursday
+func (cal COCal) IsHolyThursday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-4
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal COCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsAscension checks for Ascension Day, on the Monday after
+func (cal COCal) IsAscension(y int, dd int) bool {
+	if EmilianiCO.Covers(y) {
+		return dd == cal.EasterMonday(y)+42
+	}
+
+	return dd == cal.EasterMonday(y)+38
+}
+
+//IsCorpusChristi checks for Corpus Christi, on the Monday after
+func (cal COCal) IsCorpusChristi(y int, dd int) bool {
+	if EmilianiCO.Covers(y) {
+		return dd == cal.EasterMonday(y)+63
+	}
+
+	return dd == cal.EasterMonday(y)+59
+}
+
+//IsSacredHeart checks for the Sacred Heart, on the Monday after
+func (cal COCal) IsSacredHeart(y int, dd int) bool {
+	if EmilianiCO.Covers(y) {
+		return dd == cal.EasterMonday(y)+70
+	}
+
+	return dd == cal.EasterMonday(y)+67
+}
+
+//HolidayName names Colombian holidays
+func (cal COCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	dd := t.YearDay()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsEmiliani(y, m, d, time.January, 6):
+		return "Epiphany", true
+	case cal.IsEmiliani(y, m, d, time.March, 19):
+		return "Saint Joseph's Day", true
+	case cal.IsHolyThursday(y, dd):
+		return "Holy Thursday", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case cal.IsAscension(y, dd):
+		return "Ascension Day", true
+	case cal.IsCorpusChristi(y, dd):
+		return "Corpus Christi", true
+	case cal.IsSacredHeart(y, dd):
+		return "Sacred Heart", true
+	case cal.IsEmiliani(y, m, d, time.June, 29):
+		return "Saints Peter and Paul", true
+	case d == 20 && m == time.July:
+		return "Independence Day", true
+	case d == 7 && m == time.August:
+		return "Battle of Boyacá", true
+	case cal.IsEmiliani(y, m, d, time.August, 15):
+		return "Assumption Day", true
+	case cal.IsEmiliani(y, m, d, time.October, 12):
+		return "Columbus Day", true
+	case cal.IsEmiliani(y, m, d, time.November, 1):
+		return "All Saints' Day", true
+	case cal.IsEmiliani(y, m, d, time.November, 11):
+		return "Independence of Cartagena", true
+	case d == 8 && m == time.December:
+		return "Immaculate Conception", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	}
+
+	return "", false
+}
+
+//BVCCal, calendar for the Colombian Stock Exchange
+//has all COCal methods
+//It also satisfies BizCal interface
+type BVCCal struct {
+	COCal
+}
+
+//IsBusinessDay checks for business day according to BVC Calendar
+func (cal BVCCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
@@ -0,0 +1,39 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCOHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Ley Emiliani, to the Monday on or after the date
+		{time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC), "Epiphany"},
+		{time.Date(2024, time.January, 6, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC), "Saint Joseph's Day"},
+		{time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), "Saints Peter and Paul"},
+		{time.Date(2024, time.August, 19, 0, 0, 0, 0, time.UTC), "Assumption Day"},
+		{time.Date(2024, time.October, 14, 0, 0, 0, 0, time.UTC), "Columbus Day"},
+		{time.Date(2024, time.November, 4, 0, 0, 0, 0, time.UTC), "All Saints' Day"},
+		{time.Date(2024, time.November, 11, 0, 0, 0, 0, time.UTC), "Independence of Cartagena"},
+		// Easter, March 31st 2024, and the Mondays after Ascension,
+		// Corpus Christi and the Sacred Heart
+		{time.Date(2024, time.March, 28, 0, 0, 0, 0, time.UTC), "Holy Thursday"},
+		{time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC), "Ascension Day"},
+		{time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC), "Corpus Christi"},
+		{time.Date(2024, time.May, 30, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC), "Sacred Heart"},
+		// fixed dates before the law
+		{time.Date(1983, time.January, 6, 0, 0, 0, 0, time.UTC), "Epiphany"},
+		{time.Date(1983, time.June, 2, 0, 0, 0, 0, time.UTC), "Corpus Christi"},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (COCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("CO %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
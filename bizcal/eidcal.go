@@ -0,0 +1,461 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of holidays of countries observing the Eids
+var (
+	NationalDaySA       = Effective{From: 2005}
+	FoundingDaySA       = Effective{From: 2022}
+	EidAlFitrRamadanAE  = Effective{From: 2019}
+	IsraMirajAE         = Effective{To: 2018}
+	CommemorationDayAE  = Effective{From: 2015}
+	CommemorationDec1AE = Effective{From: 2019}
+	LabourDayTR         = Effective{From: 2009}
+	DemocracyDayTR      = Effective{From: 2017}
+	ChineseNewYearID    = Effective{From: 2003}
+	PancasilaDayID      = Effective{From: 2017}
+)
+
+//Weekend changes, the first day of the new weekend
+var (
+	saFriSatWeekend = time.Date(2013, time.June, 29, 0, 0, 0, 0, time.UTC)
+	aeFriSatWeekend = time.Date(2006, time.September, 1, 0, 0, 0, 0, time.UTC)
+	aeSatSunWeekend = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+//saAnnounced lists the month starts Saudi Arabia announced on sighting
+//for Ramadan, Shawwal and Dhu al-Hijjah, the UAE announced the same
+var saAnnounced = map[[2]int]time.Time{
+	{1440, Ramadan}:    time.Date(2019, time.May, 6, 0, 0, 0, 0, time.UTC),
+	{1440, Shawwal}:    time.Date(2019, time.June, 4, 0, 0, 0, 0, time.UTC),
+	{1440, DhulHijjah}: time.Date(2019, time.August, 2, 0, 0, 0, 0, time.UTC),
+	{1441, Ramadan}:    time.Date(2020, time.April, 24, 0, 0, 0, 0, time.UTC),
+	{1441, Shawwal}:    time.Date(2020, time.May, 24, 0, 0, 0, 0, time.UTC),
+	{1441, DhulHijjah}: time.Date(2020, time.July, 22, 0, 0, 0, 0, time.UTC),
+	{1442, Ramadan}:    time.Date(2021, time.April, 13, 0, 0, 0, 0, time.UTC),
+	{1442, Shawwal}:    time.Date(2021, time.May, 13, 0, 0, 0, 0, time.UTC),
+	{1442, DhulHijjah}: time.Date(2021, time.July, 11, 0, 0, 0, 0, time.UTC),
+	{1443, Ramadan}:    time.Date(2022, time.April, 2, 0, 0, 0, 0, time.UTC),
+	{1443, Shawwal}:    time.Date(2022, time.May, 2, 0, 0, 0, 0, time.UTC),
+	{1443, DhulHijjah}: time.Date(2022, time.June, 30, 0, 0, 0, 0, time.UTC),
+	{1444, Ramadan}:    time.Date(2023, time.March, 23, 0, 0, 0, 0, time.UTC),
+	{1444, Shawwal}:    time.Date(2023, time.April, 21, 0, 0, 0, 0, time.UTC),
+	{1444, DhulHijjah}: time.Date(2023, time.June, 19, 0, 0, 0, 0, time.UTC),
+	{1445, Ramadan}:    time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+	{1445, Shawwal}:    time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC),
+	{1445, DhulHijjah}: time.Date(2024, time.June, 7, 0, 0, 0, 0, time.UTC),
+	{1446, Ramadan}:    time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+	{1446, Shawwal}:    time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC),
+	{1446, DhulHijjah}: time.Date(2025, time.May, 28, 0, 0, 0, 0, time.UTC),
+	{1447, Ramadan}:    time.Date(2026, time.February, 18, 0, 0, 0, 0, time.UTC),
+	{1447, Shawwal}:    time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC),
+}
+
+//idAnnounced lists the month starts behind the holidays
+//Indonesia's government set, where they differ from Umm al-Qura
+var idAnnounced = map[[2]int]time.Time{
+	{1444, Shawwal}:     time.Date(2023, time.April, 22, 0, 0, 0, 0, time.UTC),
+	{1444, Rajab}:       time.Date(2023, time.January, 23, 0, 0, 0, 0, time.UTC),
+	{1444, DhulHijjah}:  time.Date(2023, time.June, 20, 0, 0, 0, 0, time.UTC),
+	{1445, Muharram}:    time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC),
+	{1445, RabiAlAwwal}: time.Date(2023, time.September, 17, 0, 0, 0, 0, time.UTC),
+	{1445, Rajab}:       time.Date(2024, time.January, 13, 0, 0, 0, 0, time.UTC),
+	{1445, DhulHijjah}:  time.Date(2024, time.June, 8, 0, 0, 0, 0, time.UTC),
+	{1446, RabiAlAwwal}: time.Date(2024, time.September, 5, 0, 0, 0, 0, time.UTC),
+	{1446, Rajab}:       time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+	{1446, Shawwal}:     time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+	{1447, Muharram}:    time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC),
+	{1447, RabiAlAwwal}: time.Date(2025, time.August, 25, 0, 0, 0, 0, time.UTC),
+	{1447, Shawwal}:     time.Date(2026, time.March, 21, 0, 0, 0, 0, time.UTC),
+}
+
+//aeMoved lists the holidays the UAE moved to another day,
+//from the day they fall on to the day they were observed
+var aeMoved = map[time.Time]time.Time{
+	// Islamic New Year and the Prophet's Birthday moved to Fridays
+	time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC):      time.Date(2023, time.July, 21, 0, 0, 0, 0, time.UTC),
+	time.Date(2023, time.September, 27, 0, 0, 0, 0, time.UTC): time.Date(2023, time.September, 29, 0, 0, 0, 0, time.UTC),
+	time.Date(2025, time.June, 26, 0, 0, 0, 0, time.UTC):      time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC),
+	time.Date(2025, time.September, 4, 0, 0, 0, 0, time.UTC):  time.Date(2025, time.September, 5, 0, 0, 0, 0, time.UTC),
+}
+
+//Hijri calendars of the countries, Turkey's calculated calendar
+//is approximated by Umm al-Qura
+var (
+	saHijri = HijriCalendar{Method: UmmAlQura, Announced: saAnnounced}
+	aeHijri = HijriCalendar{Method: UmmAlQura, Announced: saAnnounced}
+	trHijri = HijriCalendar{Method: UmmAlQura}
+	idHijri = HijriCalendar{Method: UmmAlQura, Announced: idAnnounced}
+)
+
+//idFestivals lists Nyepi and Waisak as month and day, other years
+//have Waisak on the 15th of the 4th lunar month and Nyepi from nyepiDay
+var idFestivals = map[int][2][2]int{
+	2022: {{3, 3}, {5, 16}},
+	2023: {{3, 22}, {6, 4}},
+	2024: {{3, 11}, {5, 23}},
+	2025: {{3, 29}, {5, 12}},
+	2026: {{3, 19}, {5, 31}},
+}
+
+//idCollectiveLeave lists the collective leave days (cuti bersama)
+//the exchange closes for, as month and day by year
+var idCollectiveLeave = map[int][][2]int{
+	2023: {{1, 23}, {3, 23}, {4, 19}, {4, 20}, {4, 21}, {4, 24}, {4, 25}, {6, 2}, {6, 28}, {6, 30}, {12, 26}},
+	2024: {{2, 9}, {3, 12}, {4, 8}, {4, 9}, {4, 12}, {4, 15}, {5, 10}, {5, 24}, {6, 18}, {12, 26}},
+	2025: {{1, 28}, {3, 28}, {4, 2}, {4, 3}, {4, 4}, {4, 7}, {5, 13}, {5, 30}, {6, 9}, {12, 26}},
+	2026: {{2, 16}, {3, 18}, {3, 20}, {3, 23}, {3, 24}, {5, 15}, {5, 28}, {12, 24}},
+}
+
+//idElections lists the general and regional election days,
+//which are public holidays the exchange closes for
+var idElections = map[time.Time]string{
+	time.Date(2018, time.June, 27, 0, 0, 0, 0, time.UTC):     "Regional Election Day",
+	time.Date(2019, time.April, 17, 0, 0, 0, 0, time.UTC):    "General Election Day",
+	time.Date(2020, time.December, 9, 0, 0, 0, 0, time.UTC):  "Regional Election Day",
+	time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC): "General Election Day",
+	time.Date(2024, time.November, 27, 0, 0, 0, 0, time.UTC): "Regional Election Day",
+}
+
+//nyepiDay approximates the Balinese Day of Silence, the day after the new moon
+//of the ninth Saka month, by the March new moon taken at UTC+18,
+//which gives the published day in most years and is a day late in the others
+func nyepiDay(y int) time.Time {
+	z := time.FixedZone("UTC+18", 18*3600)
+	return dayIn(newMoon(newMoonBefore(time.Date(y, time.April, 1, 0, 0, 0, 0, z))), z)
+}
+
+//hijriSpan checks for a day from one Hijri date on for a number of days
+func hijriSpan(hc HijriCalendar, t time.Time, from HijriDate, days int) bool {
+	start := hc.ToGregorian(from)
+	n := int(dateKey(t).Sub(start).Hours() / 24)
+	return n >= 0 && n < days
+}
+
+//TadawulCal, calendar for the Saudi Exchange
+//The weekend is Friday and Saturday, Thursday and Friday before June 2013
+//has all BasicCal methods
+//It also satisfies BizCal interface
+type TadawulCal struct {
+	BasicCal
+}
+
+//IsWeekend checks for the Saudi weekend
+func (cal TadawulCal) IsWeekend(t time.Time) bool {
+	w := t.Weekday()
+	if dateKey(t).Before(saFriSatWeekend) {
+		return w == time.Thursday || w == time.Friday
+	}
+
+	return w == time.Friday || w == time.Saturday
+}
+
+//IsWeekday checks for a day outside the Saudi weekend
+func (cal TadawulCal) IsWeekday(t time.Time) bool {
+	return !(cal.IsWeekend(t))
+}
+
+//IsBusinessDay checks for business day according to Saudi Exchange Calendar
+func (cal TadawulCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Saudi Exchange holidays, four days of Eid al-Fitr from
+//the day after the 29th of Ramadan and four days of Eid al-Adha from
+//Arafat Day, national days on a Friday move to Thursday and on a Saturday to Sunday
+func (cal TadawulCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	h := saHijri.FromGregorian(t)
+
+	// the fixed national days, moved off the weekend
+	fixed := func(fm time.Month, fd int) bool {
+		day := time.Date(y, fm, fd, 0, 0, 0, 0, time.UTC)
+		switch day.Weekday() {
+		case time.Friday:
+			day = day.AddDate(0, 0, -1)
+		case time.Saturday:
+			day = day.AddDate(0, 0, 1)
+		}
+		return m == day.Month() && d == day.Day()
+	}
+
+	switch {
+	case hijriSpan(saHijri, t, HijriDate{Year: h.Year, Month: Ramadan, Day: 30}, 4):
+		return "Eid al-Fitr", true
+	case hijriSpan(saHijri, t, HijriDate{Year: h.Year, Month: DhulHijjah, Day: 9}, 4):
+		return "Eid al-Adha", true
+	case FoundingDaySA.Covers(y) && fixed(time.February, 22):
+		return "Founding Day", true
+	case NationalDaySA.Covers(y) && fixed(time.September, 23):
+		return "National Day", true
+	}
+
+	return "", false
+}
+
+//UAECal for UAE public holidays
+//The weekend is Saturday and Sunday since 2022, Friday and Saturday
+//from September 2006 and Thursday and Friday before
+//has all BasicCal methods
+type UAECal struct {
+	BasicCal
+}
+
+//IsWeekend checks for the UAE weekend
+func (cal UAECal) IsWeekend(t time.Time) bool {
+	w := t.Weekday()
+	switch day := dateKey(t); {
+	case day.Before(aeFriSatWeekend):
+		return w == time.Thursday || w == time.Friday
+	case day.Before(aeSatSunWeekend):
+		return w == time.Friday || w == time.Saturday
+	}
+
+	return w == time.Saturday || w == time.Sunday
+}
+
+//IsWeekday checks for a day outside the UAE weekend
+func (cal UAECal) IsWeekday(t time.Time) bool {
+	return !(cal.IsWeekend(t))
+}
+
+//HolidayName names UAE public holidays, Eid al-Fitr runs from
+//the 29th of Ramadan since 2019, holidays in aeMoved are named on the day observed
+func (cal UAECal) HolidayName(t time.Time) (string, bool) {
+	day := dateKey(t)
+	for from, to := range aeMoved {
+		if day.Equal(to) {
+			return cal.holiday(from)
+		}
+	}
+	if _, ok := aeMoved[day]; ok {
+		return "", false
+	}
+
+	return cal.holiday(t)
+}
+
+//holiday names the UAE public holiday falling on a day
+func (cal UAECal) holiday(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	h := aeHijri.FromGregorian(t)
+
+	fitr := HijriDate{Year: h.Year, Month: Shawwal, Day: 1}
+	fitrDays := 3
+	if EidAlFitrRamadanAE.Covers(y) {
+		fitr = HijriDate{Year: h.Year, Month: Ramadan, Day: 29}
+		fitrDays = int(aeHijri.MonthStart(h.Year, Shawwal).Sub(aeHijri.ToGregorian(fitr)).Hours()/24) + 3
+	}
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case IsraMirajAE.Covers(y) && h.Month == Rajab && h.Day == 27:
+		return "Isra and Mi'raj", true
+	case hijriSpan(aeHijri, t, fitr, fitrDays):
+		return "Eid al-Fitr", true
+	case h.Month == DhulHijjah && h.Day == 9:
+		return "Arafat Day", true
+	case h.Month == DhulHijjah && h.Day >= 10 && h.Day <= 12:
+		return "Eid al-Adha", true
+	case h.Month == Muharram && h.Day == 1:
+		return "Islamic New Year", true
+	case h.Month == RabiAlAwwal && h.Day == 12:
+		return "Prophet's Birthday", true
+	case CommemorationDec1AE.Covers(y) && d == 1 && m == time.December:
+		return "Commemoration Day", true
+	case CommemorationDayAE.Covers(y) && y < CommemorationDec1AE.From && d == 30 && m == time.November:
+		return "Commemoration Day", true
+	case m == time.December && (d == 2 || d == 3):
+		return "National Day", true
+	}
+
+	return "", false
+}
+
+//DFMCal, calendar for the Dubai Financial Market
+//has all UAECal methods
+//It also satisfies BizCal interface
+type DFMCal struct {
+	UAECal
+}
+
+//IsBusinessDay checks for business day according to DFM Calendar
+func (cal DFMCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//ADXCal, calendar for the Abu Dhabi Securities Exchange
+//has all UAECal methods
+//It also satisfies BizCal interface
+type ADXCal struct {
+	UAECal
+}
+
+//IsBusinessDay checks for business day according to ADX Calendar
+func (cal ADXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//BorsaIstanbulCal, calendar for Borsa Istanbul
+//has all BasicCal methods
+//It also satisfies BizCal interface
+type BorsaIstanbulCal struct {
+	BasicCal
+}
+
+//IsBusinessDay checks for business day according to Borsa Istanbul Calendar
+func (cal BorsaIstanbulCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Borsa Istanbul holidays, three days of the Ramadan Feast
+//and four days of the Feast of the Sacrifice
+func (cal BorsaIstanbulCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	h := trHijri.FromGregorian(t)
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case d == 23 && m == time.April:
+		return "National Sovereignty and Children's Day", true
+	case LabourDayTR.Covers(y) && d == 1 && m == time.May:
+		return "Labour and Solidarity Day", true
+	case d == 19 && m == time.May:
+		return "Commemoration of Atatürk, Youth and Sports Day", true
+	case DemocracyDayTR.Covers(y) && d == 15 && m == time.July:
+		return "Democracy and National Unity Day", true
+	case d == 30 && m == time.August:
+		return "Victory Day", true
+	case d == 29 && m == time.October:
+		return "Republic Day", true
+	case h.Month == Shawwal && h.Day <= 3:
+		return "Ramadan Feast", true
+	case h.Month == DhulHijjah && h.Day >= 10 && h.Day <= 13:
+		return "Feast of the Sacrifice", true
+	}
+
+	return "", false
+}
+
+//EarlyClose returns the 12:30 close of the half days on the eves
+//of the feasts and of Republic Day
+func (cal BorsaIstanbulCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	next := trHijri.FromGregorian(dateKey(t).AddDate(0, 0, 1))
+	if (m == time.October && d == 28) || (next.Month == Shawwal && next.Day == 1) ||
+		(next.Month == DhulHijjah && next.Day == 10) {
+		return closeAt(t, 12, 30, Istanbul), true
+	}
+
+	return time.Time{}, false
+}
+
+//IDXCal, calendar for the Indonesia Stock Exchange, public holidays,
+//election days, collective leave days and the year end closing
+//has all BasicCal methods
+//It also satisfies BizCal interface
+type IDXCal struct {
+	BasicCal
+}
+
+//IsBusinessDay checks for business day according to IDX Calendar
+func (cal IDXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names IDX holidays
+func (cal IDXCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	dd := t.YearDay()
+	h := idHijri.FromGregorian(t)
+	em := cal.EasterMonday(y)
+
+	nyepi, waisak := time.Time{}, time.Time{}
+	if f, ok := idFestivals[y]; ok {
+		nyepi = time.Date(y, time.Month(f[0][0]), f[0][1], 0, 0, 0, 0, time.UTC)
+		waisak = time.Date(y, time.Month(f[1][0]), f[1][1], 0, 0, 0, 0, time.UTC)
+	} else {
+		nyepi = nyepiDay(y)
+		waisak, _ = LunarToSolar(LunarDate{Year: y, Month: 4, Day: 15}, ChinaTime)
+	}
+
+	switch day := dateKey(t); {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case ChineseNewYearID.Covers(y) && day.Equal(LunarNewYear(y, ChinaTime)):
+		return "Chinese New Year", true
+	case h.Month == Rajab && h.Day == 27:
+		return "Isra and Mi'raj", true
+	case day.Equal(nyepi):
+		return "Nyepi", true
+	case dd == em-3:
+		return "Good Friday", true
+	case h.Month == Shawwal && h.Day <= 2:
+		return "Eid al-Fitr", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case dd == em+38:
+		return "Ascension Day", true
+	case day.Equal(waisak):
+		return "Waisak", true
+	case PancasilaDayID.Covers(y) && d == 1 && m == time.June:
+		return "Pancasila Day", true
+	case h.Month == DhulHijjah && h.Day == 10:
+		return "Eid al-Adha", true
+	case h.Month == Muharram && h.Day == 1:
+		return "Islamic New Year", true
+	case d == 17 && m == time.August:
+		return "Independence Day", true
+	case h.Month == RabiAlAwwal && h.Day == 12:
+		return "Prophet's Birthday", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	case d == 31 && m == time.December:
+		return "Exchange Holiday", true
+	}
+
+	if name, ok := idElections[dateKey(t)]; ok {
+		return name, true
+	}
+
+	for _, md := range idCollectiveLeave[y] {
+		if m == time.Month(md[0]) && d == md[1] {
+			return "Collective Leave", true
+		}
+	}
+
+	return "", false
+}
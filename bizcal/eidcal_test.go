@@ -0,0 +1,52 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUAEMovedHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2023, time.July, 21, 0, 0, 0, 0, time.UTC), "Islamic New Year"},
+		{time.Date(2023, time.September, 27, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2023, time.September, 29, 0, 0, 0, 0, time.UTC), "Prophet's Birthday"},
+		{time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC), "Islamic New Year"},
+	}
+	for _, tt := range tests {
+		if name, _ := (UAECal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("UAECal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestIDXNyepi(t *testing.T) {
+	// the fallback outside the festival table
+	for _, day := range []time.Time{
+		time.Date(2019, time.March, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.March, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC),
+	} {
+		if name, _ := (IDXCal{}).HolidayName(day); name != "Nyepi" {
+			t.Errorf("IDXCal %s is %q, want Nyepi", day.Format("2006-01-02"), name)
+		}
+	}
+}
+
+func TestIDXElections(t *testing.T) {
+	for _, tt := range []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC), "General Election Day"},
+		{time.Date(2024, time.November, 27, 0, 0, 0, 0, time.UTC), "Regional Election Day"},
+		{time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC), ""},
+	} {
+		if name, _ := (IDXCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("IDXCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package bizcal
+
+import (
+	"math"
+	"time"
+)
+
+/*
+Equinox and solstice times after Jean Meeus, Astronomical Algorithms, 2nd ed., chapter 27
+Accurate to about a minute for the years 1000 to 3000
+*/
+
+//equinoxTerms are the periodic terms A, B, C of Meeus table 27.C
+var equinoxTerms = [...][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186},
+	{182, 27.85, 445267.112}, {156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906}, {70, 243.58, 9037.513},
+	{58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417},
+	{18, 155.12, 67555.328}, {17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848}, {12, 287.11, 31931.756},
+	{12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+//MarchEquinox returns the instant of the March equinox of a year in UTC
+func MarchEquinox(year int) time.Time {
+	y := (float64(year) - 2000) / 1000
+	jde0 := 2451623.80984 + 365242.37404*y + 0.05169*y*y - 0.00411*y*y*y - 0.00057*y*y*y*y
+	return equinoxTime(year, jde0)
+}
+
+//SeptemberEquinox returns the instant of the September equinox of a year in UTC
+func SeptemberEquinox(year int) time.Time {
+	y := (float64(year) - 2000) / 1000
+	jde0 := 2451810.21715 + 365242.01767*y - 0.11575*y*y + 0.00337*y*y*y + 0.00078*y*y*y*y
+	return equinoxTime(year, jde0)
+}
+
+//JuneSolstice returns the instant of the June solstice of a year in UTC
+func JuneSolstice(year int) time.Time {
+	y := (float64(year) - 2000) / 1000
+	jde0 := 2451716.56767 + 365241.62603*y + 0.00325*y*y + 0.00888*y*y*y - 0.00030*y*y*y*y
+	return equinoxTime(year, jde0)
+}
+
+//DecemberSolstice returns the instant of the December solstice of a year in UTC
+func DecemberSolstice(year int) time.Time {
+	y := (float64(year) - 2000) / 1000
+	jde0 := 2451900.05952 + 365242.74049*y - 0.06223*y*y - 0.00823*y*y*y + 0.00032*y*y*y*y
+	return equinoxTime(year, jde0)
+}
+
+//equinoxTime corrects a mean equinox or solstice for the periodic terms and
+//converts it from dynamical time to UTC
+func equinoxTime(year int, jde0 float64) time.Time {
+	rad := math.Pi / 180
+	t := (jde0 - 2451545.0) / 36525
+	w := (35999.373*t - 2.47) * rad
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	s := 0.0
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos((term[1]+term[2]*t)*rad)
+	}
+
+	jde := jde0 + 0.00001*s/dl
+	return julianDayTime(jde).Add(-time.Duration(deltaT(year) * float64(time.Second)))
+}
+
+//julianDayTime converts a julian day to time
+func julianDayTime(jd float64) time.Time {
+	// julian day 2440587.5 is the unix epoch
+	// whole seconds and a nanosecond remainder, a Duration
+	// only spans the years 1678 to 2262
+	sec := math.Floor((jd - 2440587.5) * 86400)
+	nsec := ((jd-2440587.5)*86400 - sec) * 1e9
+	return time.Unix(int64(sec), int64(nsec)).UTC()
+}
+
+//deltaT approximates TD - UT in seconds, after Espenak and Meeus
+func deltaT(year int) float64 {
+	y := float64(year)
+
+	switch {
+	case year < 1900:
+		// the long term parabola, the polynomials below
+		// diverge outside their spans
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case year < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
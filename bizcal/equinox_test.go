@@ -0,0 +1,35 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEquinoxDays(t *testing.T) {
+	// equinox days in Japan time, including years past the span of a time.Duration
+	tests := []struct {
+		year             int
+		vernal, autumnal int
+	}{
+		{2000, 20, 23},
+		{2024, 20, 22},
+		{2025, 20, 23},
+		{2263, 21, 23},
+		{2300, 21, 23},
+	}
+
+	for _, tt := range tests {
+		if d := MarchEquinox(tt.year).In(Tokyo).Day(); d != tt.vernal {
+			t.Errorf("MarchEquinox(%d) falls on March %d, want %d", tt.year, d, tt.vernal)
+		}
+		if d := SeptemberEquinox(tt.year).In(Tokyo).Day(); d != tt.autumnal {
+			t.Errorf("SeptemberEquinox(%d) falls on September %d, want %d", tt.year, d, tt.autumnal)
+		}
+	}
+
+	if name, ok := (JPCal{}).HolidayName(time.Date(2263, time.March, 21, 0, 0, 0, 0, time.UTC)); !ok {
+		t.Errorf("2263-03-21 is not a holiday, want Vernal Equinox Day")
+	} else if name != "Vernal Equinox Day" {
+		t.Errorf("2263-03-21 is %q, want Vernal Equinox Day", name)
+	}
+}
@@ -0,0 +1,138 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of TARGET closing days
+//TARGET went live on January 4th 1999 and only closed on
+//Christmas and New Year's Day in its first year
+var (
+	TARGETSystem       = Effective{From: 1999}
+	TARGETEasterLabour = Effective{From: 2000}
+)
+
+//EUCal for holidays shared across European calendars
+//has all BasicCal methods
+type EUCal struct {
+	BasicCal
+}
+
+//IsNewYearsDay checks for New Year's Day, January 1st
+func (cal EUCal) IsNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 1 && m == time.January
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal EUCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsEasterMonday checks for Easter Monday
+func (cal EUCal) IsEasterMonday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)
+}
+
+//IsLabourDay checks for Labour Day, May 1st
+func (cal EUCal) IsLabourDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 1 && m == time.May
+}
+
+//IsAssumption checks for the Assumption, August 15th
+func (cal EUCal) IsAssumption(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 15 && m == time.August
+}
+
+//IsChristmasEve checks for Christmas Eve, December 24th
+func (cal EUCal) IsChristmasEve(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 24 && m == time.December
+}
+
+//IsChristmas checks for Christmas, December 25th
+func (cal EUCal) IsChristmas(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 25 && m == time.December
+}
+
+//IsStStephensDay checks for December 26th
+func (cal EUCal) IsStStephensDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 26 && m == time.December
+}
+
+//IsNewYearsEve checks for New Year's Eve, December 31st
+func (cal EUCal) IsNewYearsEve(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 31 && m == time.December
+}
+
+//IsEpiphany checks for Epiphany, January 6th
+func (cal EUCal) IsEpiphany(y int, m time.Month, d int, w time.Weekday) bool {
+	return d == 6 && m == time.January
+}
+
+//IsHolyThursday checks for Maundy Thursday
+func (cal EUCal) IsHolyThursday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-4
+}
+
+//IsAscension checks for Ascension Thursday
+func (cal EUCal) IsAscension(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)+38
+}
+
+//IsWhitMonday checks for Whit Monday
+func (cal EUCal) IsWhitMonday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)+49
+}
+
+//IsMidsummerEve checks for Midsummer Eve, the Friday between June 19th and 25th
+func (cal EUCal) IsMidsummerEve(y int, m time.Month, d int, w time.Weekday) bool {
+	return (d >= 19 && d <= 25) && w == time.Friday && m == time.June
+}
+
+//TARGETCal, calendar for TARGET, the Eurozone settlement system
+//has all EUCal methods
+//It also satisfies BizCal interface
+type TARGETCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to TARGET Calendar
+func (cal TARGETCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names the TARGET closing day a day falls on
+//there are no closing days before the system went live
+func (cal TARGETCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	if !TARGETSystem.Covers(y) {
+		return "", false
+	}
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case TARGETEasterLabour.Covers(y) && cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case TARGETEasterLabour.Covers(y) && cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case TARGETEasterLabour.Covers(y) && cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case TARGETEasterLabour.Covers(y) && cal.IsStStephensDay(y, m, d, w):
+		return "Christmas Holiday", true
+	case (y == 1999 || y == 2001) && cal.IsNewYearsEve(y, m, d, w):
+		// historical extra closing days
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
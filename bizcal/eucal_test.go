@@ -0,0 +1,43 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTARGET(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC), "Good Friday"},
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), "Easter Monday"},
+		{time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), "Labour Day"},
+		{time.Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		{time.Date(2024, time.December, 26, 0, 0, 0, 0, time.UTC), "Christmas Holiday"},
+		{time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.August, 15, 0, 0, 0, 0, time.UTC), ""},
+		// the first years, Christmas and New Year only in 1999,
+		// and the extra New Year's Eves of 1999 and 2001
+		{time.Date(1999, time.April, 2, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC), "New Year's Eve"},
+		{time.Date(2000, time.April, 21, 0, 0, 0, 0, time.UTC), "Good Friday"},
+		{time.Date(2000, time.December, 26, 0, 0, 0, 0, time.UTC), "Christmas Holiday"},
+		{time.Date(2001, time.December, 31, 0, 0, 0, 0, time.UTC), "New Year's Eve"},
+		{time.Date(2002, time.December, 31, 0, 0, 0, 0, time.UTC), ""},
+		// before the system went live
+		{time.Date(1998, time.December, 25, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (TARGETCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("TARGET %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+
+	if !(TARGETCal{}).IsBusinessDay(time.Date(1998, time.December, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TARGET closed on 1998-12-25, want open before 1999")
+	}
+}
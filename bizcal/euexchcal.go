@@ -0,0 +1,470 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//GreatPrayerDayDK is the span of the Danish General Prayer Day holiday
+var GreatPrayerDayDK = Effective{To: 2023}
+
+//XetraCal, calendar for Xetra and the Frankfurt Stock Exchange
+//Xetra trades on Whit Monday and German Unity Day
+//has all EUCal methods
+//It also satisfies BizCal interface
+type XetraCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Xetra Calendar
+func (cal XetraCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Xetra holidays
+func (cal XetraCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//EarlyClose returns the close time in Frankfurt when Xetra closes early,
+//at 2:00 p.m. on the last trading day of the year, December 30th
+//or the Friday before when it falls on a weekend
+func (cal XetraCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	y, m, d := t.Date()
+	if m != time.December || d < 28 || d > 30 {
+		return time.Time{}, false
+	}
+
+	// the last trading day has no trading day after it in December
+	for next := d + 1; next <= 31; next++ {
+		if cal.IsBusinessDay(time.Date(y, time.December, next, 0, 0, 0, 0, time.UTC)) {
+			return time.Time{}, false
+		}
+	}
+
+	return closeAt(t, 14, 0, Frankfurt), true
+}
+
+//EuronextCal, calendar for the Euronext cash markets in Paris,
+//Amsterdam, Brussels and Lisbon, which share one holiday schedule
+//has all EUCal methods
+//It also satisfies BizCal interface
+type EuronextCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Euronext Calendar
+func (cal EuronextCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Euronext holidays
+func (cal EuronextCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	return "", false
+}
+
+//EarlyClose returns the close time in Paris when the cash markets
+//close early, at 2:05 p.m. on Christmas Eve and New Year's Eve
+func (cal EuronextCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	y, m, d := t.Date()
+	w := t.Weekday()
+	if cal.IsChristmasEve(y, m, d, w) || cal.IsNewYearsEve(y, m, d, w) {
+		return closeAt(t, 14, 5, Paris), true
+	}
+
+	return time.Time{}, false
+}
+
+//SIXCal, calendar for SIX Swiss Exchange
+//has all EUCal methods
+//It also satisfies BizCal interface
+type SIXCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to SIX Calendar
+func (cal SIXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names SIX holidays
+func (cal SIXCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case d == 2 && m == time.January:
+		return "Berchtoldstag", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsAscension(y, dd):
+		return "Ascension Day", true
+	case cal.IsWhitMonday(y, dd):
+		return "Whit Monday", true
+	case d == 1 && m == time.August:
+		return "Swiss National Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "St. Stephen's Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//BorsaItalianaCal, calendar for Borsa Italiana
+//has all EUCal methods
+//It also satisfies BizCal interface
+type BorsaItalianaCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Borsa Italiana Calendar
+func (cal BorsaItalianaCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Borsa Italiana holidays
+func (cal BorsaItalianaCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsAssumption(y, m, d, w):
+		return "Assumption Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "St. Stephen's Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//StockholmCal, calendar for Nasdaq Stockholm
+//has all EUCal methods
+//It also satisfies BizCal interface
+type StockholmCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Nasdaq Stockholm Calendar
+func (cal StockholmCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Nasdaq Stockholm holidays
+func (cal StockholmCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsEpiphany(y, m, d, w):
+		return "Epiphany", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsAscension(y, dd):
+		return "Ascension Day", true
+	case d == 6 && m == time.June:
+		return "National Day of Sweden", true
+	case cal.IsMidsummerEve(y, m, d, w):
+		return "Midsummer Eve", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//EarlyClose returns the close time in Stockholm when the exchange
+//closes early, at 1:00 p.m. on the eves of Epiphany, Good Friday,
+//Ascension and All Saints' Day
+func (cal StockholmCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	if (d == 5 && m == time.January) ||
+		cal.IsHolyThursday(y, dd) ||
+		dd == cal.EasterMonday(y)+37 ||
+		// All Saints' Day is the Saturday between October 31st and November 6th
+		(w == time.Friday && ((d >= 30 && m == time.October) || (d <= 5 && m == time.November))) {
+		return closeAt(t, 13, 0, Stockholm), true
+	}
+
+	return time.Time{}, false
+}
+
+//OsloCal, calendar for Oslo Bors
+//has all EUCal methods
+//It also satisfies BizCal interface
+type OsloCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Oslo Bors Calendar
+func (cal OsloCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Oslo Bors holidays
+func (cal OsloCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsHolyThursday(y, dd):
+		return "Maundy Thursday", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case d == 17 && m == time.May:
+		return "Constitution Day", true
+	case cal.IsAscension(y, dd):
+		return "Ascension Day", true
+	case cal.IsWhitMonday(y, dd):
+		return "Whit Monday", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//CopenhagenCal, calendar for Nasdaq Copenhagen
+//has all EUCal methods
+//It also satisfies BizCal interface
+type CopenhagenCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Nasdaq Copenhagen Calendar
+func (cal CopenhagenCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Nasdaq Copenhagen holidays
+func (cal CopenhagenCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+	em := cal.EasterMonday(y)
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsHolyThursday(y, dd):
+		return "Maundy Thursday", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case GreatPrayerDayDK.Covers(y) && dd == em+25:
+		// fourth Friday after Easter, abolished from 2024
+		return "General Prayer Day", true
+	case cal.IsAscension(y, dd):
+		return "Ascension Day", true
+	case dd == em+39:
+		return "Day after Ascension", true
+	case cal.IsWhitMonday(y, dd):
+		return "Whit Monday", true
+	case d == 5 && m == time.June:
+		return "Constitution Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
+
+//HelsinkiCal, calendar for Nasdaq Helsinki
+//has all EUCal methods
+//It also satisfies BizCal interface
+type HelsinkiCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Nasdaq Helsinki Calendar
+func (cal HelsinkiCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Nasdaq Helsinki holidays
+func (cal HelsinkiCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "May Day", true
+	case cal.IsMidsummerEve(y, m, d, w):
+		return "Midsummer Eve", true
+	case d == 6 && m == time.December:
+		return "Independence Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	case cal.IsNewYearsEve(y, m, d, w):
+		return "New Year's Eve", true
+	}
+
+	return "", false
+}
@@ -0,0 +1,33 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestXetraEarlyClose(t *testing.T) {
+	// the last trading day of the year closes at 2:00 p.m.
+	tests := []struct {
+		day   time.Time
+		early bool
+	}{
+		{time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, time.December, 29, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, time.December, 28, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2025, time.December, 30, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC), false},
+		// the last year of the calendar
+		{time.Date(2199, time.December, 30, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		c, ok := (XetraCal{}).EarlyClose(tt.day)
+		if ok != tt.early {
+			t.Errorf("XetraCal.EarlyClose(%s) = %v, want %v", tt.day.Format("2006-01-02"), ok, tt.early)
+			continue
+		}
+		if ok && c.In(Frankfurt).Hour() != 14 {
+			t.Errorf("XetraCal.EarlyClose(%s) = %s, want 14:00 in Frankfurt", tt.day.Format("2006-01-02"), c)
+		}
+	}
+}
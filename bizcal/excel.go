@@ -0,0 +1,174 @@
+package bizcal
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+Excel compatible workday functions
+
+WorkDay, NetWorkDays, WorkDayIntl and NetWorkDaysIntl reproduce the
+spreadsheet functions WORKDAY, NETWORKDAYS, WORKDAY.INTL and
+NETWORKDAYS.INTL. The holidays of the calendar passed in are added to
+the explicit holiday list, so a nil calendar gives plain Excel results.
+Times of day are dropped the same way Excel truncates date serials.
+*/
+
+//Weekend is the set of weekdays treated as weekend, indexed by time.Weekday
+type Weekend [7]bool
+
+//SaturdaySunday is the default weekend used by WORKDAY and NETWORKDAYS
+var SaturdaySunday = Weekend{time.Sunday: true, time.Saturday: true}
+
+//WeekendFromCode returns the weekend for an Excel weekend number
+//1-7 are two day weekends, 11-17 are single day weekends
+func WeekendFromCode(code int) (Weekend, error) {
+	var wk Weekend
+
+	switch {
+	case code >= 1 && code <= 7:
+		// 1 is Saturday and Sunday, 2 is Sunday and Monday, ...
+		first := time.Weekday((code + 5) % 7)
+		wk[first] = true
+		wk[(first+1)%7] = true
+	case code >= 11 && code <= 17:
+		// 11 is Sunday only, 12 is Monday only, ...
+		wk[time.Weekday(code-11)] = true
+	default:
+		return wk, fmt.Errorf("bizcal: invalid weekend code %d", code)
+	}
+
+	return wk, nil
+}
+
+//WeekendFromString returns the weekend for an Excel weekend string
+//such as "0000011", seven characters starting on Monday, 1 is weekend
+func WeekendFromString(s string) (Weekend, error) {
+	var wk Weekend
+
+	if len(s) != 7 {
+		return wk, fmt.Errorf("bizcal: invalid weekend string %q", s)
+	}
+
+	for i := 0; i < 7; i++ {
+		switch s[i] {
+		case '0':
+		case '1':
+			wk[(i+1)%7] = true
+		default:
+			return wk, fmt.Errorf("bizcal: invalid weekend string %q", s)
+		}
+	}
+
+	return wk, nil
+}
+
+//isAllWeek checks if every day of the week is weekend
+func (wk Weekend) isAllWeek() bool {
+	for _, v := range wk {
+		if !v {
+			return false
+		}
+	}
+
+	return true
+}
+
+//workDays holds the weekend and holiday set for one Excel evaluation
+type workDays struct {
+	cal      BizCal
+	weekend  Weekend
+	holidays map[time.Time]bool
+}
+
+func newWorkDays(cal BizCal, weekend Weekend, holidays []time.Time) workDays {
+	wd := workDays{cal: cal, weekend: weekend, holidays: map[time.Time]bool{}}
+	for _, h := range holidays {
+		wd.holidays[dateKey(h)] = true
+	}
+
+	return wd
+}
+
+//isWorkDay checks if a day is neither weekend nor holiday
+//calendar holidays are the calendar's weekdays that are not business days
+func (wd workDays) isWorkDay(t time.Time) bool {
+	if wd.weekend[t.Weekday()] || wd.holidays[dateKey(t)] {
+		return false
+	}
+
+	if wd.cal != nil && wd.cal.IsWeekday(t) && !wd.cal.IsBusinessDay(t) {
+		return false
+	}
+
+	return true
+}
+
+func (wd workDays) add(start time.Time, days int) time.Time {
+	rt := truncDay(start)
+	step := 1
+	if days < 0 {
+		step = -1
+		days = -days
+	}
+
+	for days > 0 {
+		rt = rt.AddDate(0, 0, step)
+		if wd.isWorkDay(rt) {
+			days--
+		}
+	}
+
+	return rt
+}
+
+func (wd workDays) count(start, end time.Time) int {
+	s, e := truncDay(start), truncDay(end)
+	sign := 1
+	if s.After(e) {
+		s, e = e, s
+		sign = -1
+	}
+
+	n := 0
+	for rt := s; !rt.After(e); rt = rt.AddDate(0, 0, 1) {
+		if wd.isWorkDay(rt) {
+			n++
+		}
+	}
+
+	return sign * n
+}
+
+//WorkDay is Excel WORKDAY, the date days working days from start
+func WorkDay(cal BizCal, start time.Time, days int, holidays ...time.Time) time.Time {
+	return newWorkDays(cal, SaturdaySunday, holidays).add(start, days)
+}
+
+//NetWorkDays is Excel NETWORKDAYS, the working days from start to end inclusive
+//the result is negative when start is after end
+func NetWorkDays(cal BizCal, start, end time.Time, holidays ...time.Time) int {
+	return newWorkDays(cal, SaturdaySunday, holidays).count(start, end)
+}
+
+//WorkDayIntl is Excel WORKDAY.INTL with a custom weekend
+//like Excel it fails when every day of the week is weekend
+func WorkDayIntl(cal BizCal, start time.Time, days int, weekend Weekend, holidays ...time.Time) (time.Time, error) {
+	if weekend.isAllWeek() {
+		return time.Time{}, fmt.Errorf("bizcal: weekend covers the whole week")
+	}
+
+	return newWorkDays(cal, weekend, holidays).add(start, days), nil
+}
+
+//NetWorkDaysIntl is Excel NETWORKDAYS.INTL with a custom weekend
+func NetWorkDaysIntl(cal BizCal, start, end time.Time, weekend Weekend, holidays ...time.Time) int {
+	return newWorkDays(cal, weekend, holidays).count(start, end)
+}
+
+//truncDay drops the time of day, keeping the location
+func truncDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,143 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestWorkDay(t *testing.T) {
+	// results of Excel WORKDAY and WORKDAY.INTL, the first rows from the Excel help
+	tests := []struct {
+		start    time.Time
+		days     int
+		weekend  Weekend
+		holidays []time.Time
+		want     time.Time
+	}{
+		{date(2008, time.October, 1), 151, SaturdaySunday, nil, date(2009, time.April, 30)},
+		{date(2008, time.October, 1), 151, SaturdaySunday,
+			[]time.Time{date(2008, time.November, 26), date(2008, time.December, 4), date(2009, time.January, 21)},
+			date(2009, time.May, 5)},
+		{date(2008, time.October, 1), -10, SaturdaySunday, nil, date(2008, time.September, 17)},
+		{date(2024, time.June, 1), 1, SaturdaySunday, nil, date(2024, time.June, 3)},
+		{date(2024, time.June, 1), -1, SaturdaySunday, nil, date(2024, time.May, 31)},
+		{date(2024, time.June, 1), 0, SaturdaySunday, nil, date(2024, time.June, 1)},
+		{date(2012, time.January, 1), 90, Weekend{time.Sunday: true}, nil, date(2012, time.April, 14)},
+		{date(2012, time.January, 1), 30, Weekend{time.Saturday: true}, nil, date(2012, time.February, 5)},
+		{date(2012, time.February, 5), -30, Weekend{time.Saturday: true}, nil, date(2012, time.January, 1)},
+	}
+
+	for _, tt := range tests {
+		got, err := WorkDayIntl(nil, tt.start, tt.days, tt.weekend, tt.holidays...)
+		if err != nil {
+			t.Fatalf("WorkDayIntl(%s, %d): %v", tt.start.Format("2006-01-02"), tt.days, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("WorkDayIntl(%s, %d) = %s, want %s",
+				tt.start.Format("2006-01-02"), tt.days, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+		if tt.weekend == SaturdaySunday {
+			if got := WorkDay(nil, tt.start, tt.days, tt.holidays...); !got.Equal(tt.want) {
+				t.Errorf("WorkDay(%s, %d) = %s, want %s",
+					tt.start.Format("2006-01-02"), tt.days, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			}
+		}
+	}
+
+	if _, err := WorkDayIntl(nil, date(2012, time.January, 1), 1, Weekend{true, true, true, true, true, true, true}); err == nil {
+		t.Errorf("WorkDayIntl with a weekend of the whole week succeeded, want an error like Excel #VALUE!")
+	}
+}
+
+func TestNetWorkDays(t *testing.T) {
+	// results of Excel NETWORKDAYS from the Excel help
+	holidays := []time.Time{date(2012, time.November, 22), date(2012, time.December, 4), date(2013, time.January, 21)}
+	tests := []struct {
+		start, end time.Time
+		holidays   []time.Time
+		want       int
+	}{
+		{date(2012, time.October, 1), date(2013, time.March, 1), nil, 110},
+		{date(2012, time.October, 1), date(2013, time.March, 1), holidays[:1], 109},
+		{date(2012, time.October, 1), date(2013, time.March, 1), holidays, 107},
+		{date(2013, time.March, 1), date(2012, time.October, 1), nil, -110},
+		{date(2024, time.June, 1), date(2024, time.June, 2), nil, 0},
+	}
+
+	for _, tt := range tests {
+		if got := NetWorkDays(nil, tt.start, tt.end, tt.holidays...); got != tt.want {
+			t.Errorf("NetWorkDays(%s, %s) = %d, want %d",
+				tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestNetWorkDaysIntl(t *testing.T) {
+	// Excel NETWORKDAYS.INTL over January 2024 for every weekend code,
+	// the month has five Mondays, Tuesdays and Wednesdays and four of the other days
+	codes := map[int]int{
+		1: 23, 2: 22, 3: 21, 4: 21, 5: 22, 6: 23, 7: 23,
+		11: 27, 12: 26, 13: 26, 14: 26, 15: 27, 16: 27, 17: 27,
+	}
+	for code, want := range codes {
+		wk, err := WeekendFromCode(code)
+		if err != nil {
+			t.Fatalf("WeekendFromCode(%d): %v", code, err)
+		}
+		if got := NetWorkDaysIntl(nil, date(2024, time.January, 1), date(2024, time.January, 31), wk); got != want {
+			t.Errorf("NetWorkDaysIntl with weekend code %d = %d, want %d", code, got, want)
+		}
+	}
+	for _, code := range []int{0, 8, 9, 10, 18} {
+		if _, err := WeekendFromCode(code); err == nil {
+			t.Errorf("WeekendFromCode(%d) succeeded, want an error like Excel #NUM!", code)
+		}
+	}
+
+	// Excel NETWORKDAYS.INTL with weekend strings, the last two from the Excel help
+	holidays := []time.Time{date(2006, time.January, 2), date(2006, time.January, 16)}
+	strs := []struct {
+		weekend    string
+		start, end time.Time
+		holidays   []time.Time
+		want       int
+	}{
+		{"0000011", date(2024, time.January, 1), date(2024, time.January, 31), nil, 23},
+		{"1000001", date(2024, time.January, 1), date(2024, time.January, 31), nil, 22},
+		{"0000000", date(2024, time.January, 1), date(2024, time.January, 31), nil, 31},
+		{"0000011", date(2024, time.January, 31), date(2024, time.January, 1), nil, -23},
+		{"0000110", date(2006, time.January, 1), date(2006, time.February, 1), holidays, 22},
+		{"0010001", date(2006, time.January, 1), date(2006, time.February, 1), holidays, 20},
+	}
+	for _, tt := range strs {
+		wk, err := WeekendFromString(tt.weekend)
+		if err != nil {
+			t.Fatalf("WeekendFromString(%q): %v", tt.weekend, err)
+		}
+		if got := NetWorkDaysIntl(nil, tt.start, tt.end, wk, tt.holidays...); got != tt.want {
+			t.Errorf("NetWorkDaysIntl(%s, %s, %q) = %d, want %d",
+				tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"), tt.weekend, got, tt.want)
+		}
+	}
+	for _, s := range []string{"000001", "00000011", "0000012", "000001 "} {
+		if _, err := WeekendFromString(s); err == nil {
+			t.Errorf("WeekendFromString(%q) succeeded, want an error like Excel #VALUE!", s)
+		}
+	}
+}
+
+func TestWorkDayHolidayLocation(t *testing.T) {
+	// a holiday with a time of day in another location still counts for its date
+	est := time.FixedZone("EST", -5*3600)
+	holiday := time.Date(2012, time.November, 22, 15, 0, 0, 0, est)
+	if got := NetWorkDays(nil, date(2012, time.November, 19), date(2012, time.November, 23), holiday); got != 4 {
+		t.Errorf("NetWorkDays with a holiday in EST = %d, want 4", got)
+	}
+	if got := WorkDay(nil, date(2012, time.November, 21), 1, holiday); !got.Equal(date(2012, time.November, 23)) {
+		t.Errorf("WorkDay with a holiday in EST = %s, want 2012-11-23", got.Format("2006-01-02"))
+	}
+}
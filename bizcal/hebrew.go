@@ -0,0 +1,171 @@
+package bizcal
+
+import (
+	"time"
+)
+
+/*
+Hebrew calendar, the fixed arithmetic calendar after Reingold and Dershowitz,
+Calendrical Calculations, chapter 8
+
+Months are numbered from Nisan as in the Bible, the year begins with Tishri,
+the 7th month, and leap years add Adar II as the 13th month
+*/
+
+//HebrewDate, a date in the Hebrew calendar
+type HebrewDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+//Hebrew months
+const (
+	Nisan = iota + 1
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+//hebrewEpoch is the fixed day before 1 Tishri AM 1, counted in days
+//from the unix epoch
+const hebrewEpoch = -1373427 - 719163
+
+//IsHebrewLeapYear checks for a Hebrew year of 13 months
+func IsHebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+//hebrewElapsedDays counts the days from the epoch to the molad of Tishri,
+//delayed a day when the molad would make Rosh Hashanah fall on
+//a Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		days++
+	}
+
+	return days
+}
+
+//hebrewYearDelay keeps the year lengths to the allowed values
+func hebrewYearDelay(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+
+	switch {
+	case ny2-ny1 == 356:
+		return 2
+	case ny1-ny0 == 382:
+		return 1
+	}
+
+	return 0
+}
+
+//hebrewNewYear is the unix day of 1 Tishri of a year
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearDelay(year)
+}
+
+//hebrewMonthDays returns the length of a month in a year
+func hebrewMonthDays(year, month int) int {
+	yearDays := hebrewNewYear(year+1) - hebrewNewYear(year)
+
+	switch {
+	case month == Iyyar || month == Tammuz || month == Elul || month == Tevet || month == AdarII:
+		return 29
+	case month == Adar && !IsHebrewLeapYear(year):
+		return 29
+	case month == Marheshvan && yearDays%10 != 5:
+		// Marheshvan is long only in complete years of 355 or 385 days
+		return 29
+	case month == Kislev && yearDays%10 == 3:
+		// Kislev is short in deficient years of 353 or 383 days
+		return 29
+	}
+
+	return 30
+}
+
+//hebrewLastMonth is the number of months of a year
+func hebrewLastMonth(year int) int {
+	if IsHebrewLeapYear(year) {
+		return AdarII
+	}
+
+	return Adar
+}
+
+//hebrewDays converts a Hebrew date to a unix day
+func hebrewDays(h HebrewDate) int {
+	days := hebrewNewYear(h.Year) + h.Day - 1
+	if h.Month < Tishri {
+		for m := Tishri; m <= hebrewLastMonth(h.Year); m++ {
+			days += hebrewMonthDays(h.Year, m)
+		}
+		for m := Nisan; m < h.Month; m++ {
+			days += hebrewMonthDays(h.Year, m)
+		}
+	} else {
+		for m := Tishri; m < h.Month; m++ {
+			days += hebrewMonthDays(h.Year, m)
+		}
+	}
+
+	return days
+}
+
+//HebrewToGregorian converts a Hebrew date to the day it falls on,
+//the result is midnight UTC of that day, the Hebrew day begins the evening before
+func HebrewToGregorian(h HebrewDate) time.Time {
+	return time.Unix(int64(hebrewDays(h))*86400, 0).UTC()
+}
+
+//GregorianToHebrew converts the day of t to the Hebrew calendar
+func GregorianToHebrew(t time.Time) HebrewDate {
+	day := dateKey(t)
+	days := int(day.Unix() / 86400)
+
+	y := day.Year() + 3760
+	if days >= hebrewNewYear(y+1) {
+		y++
+	}
+
+	m := Tishri
+	if days >= hebrewDays(HebrewDate{Year: y, Month: Nisan, Day: 1}) {
+		m = Nisan
+	}
+	for days >= hebrewDays(HebrewDate{Year: y, Month: m, Day: 1})+hebrewMonthDays(y, m) {
+		m++
+	}
+
+	return HebrewDate{Year: y, Month: m, Day: days - hebrewDays(HebrewDate{Year: y, Month: m, Day: 1}) + 1}
+}
+
+//floorDiv divides rounding towards minus infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
+
+//mod is the remainder of floorDiv, never negative for positive b
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
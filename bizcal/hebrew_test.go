@@ -0,0 +1,66 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHebrewToGregorian(t *testing.T) {
+	tests := []struct {
+		h   HebrewDate
+		day time.Time
+	}{
+		{HebrewDate{5660, Shevat, 1}, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5708, Iyyar, 5}, time.Date(1948, time.May, 14, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5760, Tevet, 23}, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5784, AdarII, 14}, time.Date(2024, time.March, 24, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5784, Nisan, 15}, time.Date(2024, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5785, Tishri, 1}, time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5785, Adar, 14}, time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5786, Tevet, 16}, time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5811, Tishri, 1}, time.Date(2050, time.September, 17, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{5861, Kislev, 29}, time.Date(2100, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := HebrewToGregorian(tt.h); !got.Equal(tt.day) {
+			t.Errorf("HebrewToGregorian(%v) = %s, want %s", tt.h, got.Format("2006-01-02"), tt.day.Format("2006-01-02"))
+		}
+		if got := GregorianToHebrew(tt.day); got != tt.h {
+			t.Errorf("GregorianToHebrew(%s) = %v, want %v", tt.day.Format("2006-01-02"), got, tt.h)
+		}
+	}
+}
+
+func TestHebrewRoundTrip(t *testing.T) {
+	prev := GregorianToHebrew(time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC))
+	for day := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() <= 2100; day = day.AddDate(0, 0, 1) {
+		h := GregorianToHebrew(day)
+		if got := HebrewToGregorian(h); !got.Equal(day) {
+			t.Fatalf("HebrewToGregorian(GregorianToHebrew(%s)) = %s", day.Format("2006-01-02"), got.Format("2006-01-02"))
+		}
+
+		// days follow each other, months as numbered from Nisan
+		switch {
+		case h.Day == prev.Day+1 && h.Month == prev.Month && h.Year == prev.Year:
+		case h.Day == 1 && h.Month == Tishri && prev.Month == Elul && h.Year == prev.Year+1:
+		case h.Day == 1 && h.Month == Nisan && prev.Month == hebrewLastMonth(prev.Year) && h.Year == prev.Year:
+		case h.Day == 1 && h.Month == prev.Month+1 && h.Year == prev.Year:
+		default:
+			t.Fatalf("GregorianToHebrew(%s) = %v follows %v", day.Format("2006-01-02"), h, prev)
+		}
+		if h.Day == 1 && prev.Day != hebrewMonthDays(prev.Year, prev.Month) {
+			t.Fatalf("%v ends after %d days, want %d", prev, prev.Day, hebrewMonthDays(prev.Year, prev.Month))
+		}
+		prev = h
+	}
+}
+
+func TestIsHebrewLeapYear(t *testing.T) {
+	// 7 leap years in every 19
+	for y, want := range map[int]bool{5784: true, 5785: false, 5786: false, 5787: true, 5790: true, 5793: true} {
+		if got := IsHebrewLeapYear(y); got != want {
+			t.Errorf("IsHebrewLeapYear(%d) = %v, want %v", y, got, want)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package bizcal
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+/*
+Islamic calendar, the tabular (arithmetic) civil calendar with the leap years
+2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30 year cycle,
+and the Umm al-Qura calendar of Saudi Arabia
+
+Actual months begin on the sighting of the crescent and can differ from
+either calendar by a day or two, a HijriCalendar takes the month starts
+an authority announced over the computed ones
+*/
+
+//HijriDate, a date in the Islamic calendar
+type HijriDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+//Islamic months used by holiday rules
+const (
+	Muharram    = 1
+	RabiAlAwwal = 3
+	Rajab       = 7
+	Ramadan     = 9
+	Shawwal     = 10
+	DhulHijjah  = 12
+)
+
+//hijriEpoch is 1 Muharram 1 AH, July 16th 622 Julian, in days since the unix epoch
+const hijriEpoch = -492148
+
+//HijriToGregorian converts a tabular Islamic date to the day it begins on,
+//the result is midnight UTC of that day
+func HijriToGregorian(h HijriDate) time.Time {
+	days := hijriEpoch - 1 + h.Day + (59*(h.Month-1)+1)/2 + (h.Year-1)*354 + (3+11*h.Year)/30
+	return time.Unix(int64(days)*86400, 0).UTC()
+}
+
+//GregorianToHijri converts the day of t to the tabular Islamic calendar
+func GregorianToHijri(t time.Time) HijriDate {
+	day := dateKey(t)
+	days := int(day.Unix() / 86400)
+
+	y := (30*(days-hijriEpoch) + 10646) / 10631
+	for day.Before(HijriToGregorian(HijriDate{Year: y, Month: 1, Day: 1})) {
+		y--
+	}
+
+	m := 12
+	for day.Before(HijriToGregorian(HijriDate{Year: y, Month: m, Day: 1})) {
+		m--
+	}
+
+	start := HijriToGregorian(HijriDate{Year: y, Month: m, Day: 1})
+	return HijriDate{Year: y, Month: m, Day: int(day.Sub(start).Hours()/24) + 1}
+}
+
+//HijriMethod is the way a HijriCalendar computes month starts
+type HijriMethod int
+
+//Hijri calendar methods
+const (
+	//TabularHijri is the arithmetic civil calendar
+	TabularHijri HijriMethod = iota
+	//UmmAlQura months come from the published table from 1300 to 1599 AH,
+	//outside it they are approximated, starting the day after the evening
+	//of the 29th when the conjunction precedes sunset in Mecca
+	//with the moon taken to set after the sun
+	UmmAlQura
+)
+
+//Mecca coordinates in degrees, for sunset
+const (
+	meccaLatitude  = 21.4225
+	meccaLongitude = 39.8262
+)
+
+//HijriCalendar converts between Gregorian days and Hijri dates
+//Announced holds the officially announced month starts, keyed by
+//Hijri year and month, that override the computed ones
+type HijriCalendar struct {
+	Method    HijriMethod
+	Announced map[[2]int]time.Time
+}
+
+//MonthStart returns the Gregorian day a Hijri month begins on,
+//the result is midnight UTC of that day
+func (cal HijriCalendar) MonthStart(year, month int) time.Time {
+	if t, ok := cal.Announced[[2]int{year, month}]; ok {
+		return dateKey(t)
+	}
+	if cal.Method == UmmAlQura {
+		return ummAlQuraMonthStart(year, month)
+	}
+
+	return HijriToGregorian(HijriDate{Year: year, Month: month, Day: 1})
+}
+
+//ToGregorian converts a Hijri date to the day it falls on, midnight UTC
+func (cal HijriCalendar) ToGregorian(h HijriDate) time.Time {
+	return cal.MonthStart(h.Year, h.Month).AddDate(0, 0, h.Day-1)
+}
+
+//FromGregorian converts the day of t to a Hijri date
+func (cal HijriCalendar) FromGregorian(t time.Time) HijriDate {
+	day := dateKey(t)
+	h := GregorianToHijri(day)
+	y, m := h.Year, h.Month
+
+	// the tabular month is at most a couple of days off
+	for day.Before(cal.MonthStart(y, m)) {
+		y, m = prevHijriMonth(y, m)
+	}
+	for {
+		ny, nm := nextHijriMonth(y, m)
+		if day.Before(cal.MonthStart(ny, nm)) {
+			break
+		}
+		y, m = ny, nm
+	}
+
+	start := cal.MonthStart(y, m)
+	return HijriDate{Year: y, Month: m, Day: int(day.Sub(start).Hours()/24) + 1}
+}
+
+//Days returns the Gregorian days of a Hijri date in a Gregorian year,
+//a year can hold the same Hijri date twice
+func (cal HijriCalendar) Days(y int, month int, day int) []time.Time {
+	var days []time.Time
+	h := GregorianToHijri(time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC))
+	for hy := h.Year - 1; hy <= h.Year+1; hy++ {
+		t := cal.ToGregorian(HijriDate{Year: hy, Month: month, Day: day})
+		if t.Year() == y {
+			days = append(days, t)
+		}
+	}
+
+	return days
+}
+
+func prevHijriMonth(y, m int) (int, int) {
+	if m == 1 {
+		return y - 1, 12
+	}
+
+	return y, m - 1
+}
+
+func nextHijriMonth(y, m int) (int, int) {
+	if m == 12 {
+		return y + 1, 1
+	}
+
+	return y, m + 1
+}
+
+//ummAlQuraCache holds computed Umm al-Qura month starts, they never change
+var ummAlQuraCache = struct {
+	sync.RWMutex
+	m map[[2]int]time.Time
+}{m: map[[2]int]time.Time{}}
+
+//ummAlQuraMonthStart returns the first day of an Umm al-Qura month,
+//from the embedded table or computed for years outside it
+func ummAlQuraMonthStart(year, month int) time.Time {
+	if start, ok := ummAlQuraTableStart(year, month); ok {
+		return start
+	}
+
+	key := [2]int{year, month}
+
+	ummAlQuraCache.RLock()
+	start, ok := ummAlQuraCache.m[key]
+	ummAlQuraCache.RUnlock()
+	if ok {
+		return start
+	}
+
+	// the conjunction closest to the tabular month start
+	tab := HijriToGregorian(HijriDate{Year: year, Month: month, Day: 1})
+	conj := newMoon(newMoonBefore(tab.AddDate(0, 0, 10)))
+
+	// the evening of the conjunction day in Mecca, UTC+3
+	day := dayIn(conj, time.FixedZone("UTC+3", 3*3600))
+	start = day.AddDate(0, 0, 2)
+	if conj.Before(sunset(day, meccaLatitude, meccaLongitude)) {
+		start = day.AddDate(0, 0, 1)
+	}
+
+	ummAlQuraCache.Lock()
+	ummAlQuraCache.m[key] = start
+	ummAlQuraCache.Unlock()
+
+	return start
+}
+
+//sunset approximates the instant of sunset on a day at a place,
+//after the NOAA solar calculator, good to a minute or two
+func sunset(day time.Time, lat, lon float64) time.Time {
+	rad := math.Pi / 180
+	g := 2 * math.Pi / 365 * float64(day.YearDay()-1)
+
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(g) - 0.032077*math.Sin(g) -
+		0.014615*math.Cos(2*g) - 0.040849*math.Sin(2*g))
+	decl := 0.006918 - 0.399912*math.Cos(g) + 0.070257*math.Sin(g) -
+		0.006758*math.Cos(2*g) + 0.000907*math.Sin(2*g) -
+		0.002697*math.Cos(3*g) + 0.00148*math.Sin(3*g)
+	ha := math.Acos(math.Cos(90.833*rad)/(math.Cos(lat*rad)*math.Cos(decl))-
+		math.Tan(lat*rad)*math.Tan(decl)) / rad
+
+	minutes := 720 - 4*(lon-ha) - eqTime
+	return dateKey(day).Add(time.Duration(minutes * float64(time.Minute)))
+}
@@ -0,0 +1,81 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUmmAlQuraMonthStarts(t *testing.T) {
+	// month starts of the published Umm al-Qura calendar
+	tests := []struct {
+		year, month int
+		start       time.Time
+	}{
+		{1300, Muharram, time.Date(1882, time.November, 12, 0, 0, 0, 0, time.UTC)},
+		{1400, Muharram, time.Date(1979, time.November, 21, 0, 0, 0, 0, time.UTC)},
+		{1420, Muharram, time.Date(1999, time.April, 17, 0, 0, 0, 0, time.UTC)},
+		{1423, Ramadan, time.Date(2002, time.November, 6, 0, 0, 0, 0, time.UTC)},
+		{1430, Ramadan, time.Date(2009, time.August, 22, 0, 0, 0, 0, time.UTC)},
+		{1435, Ramadan, time.Date(2014, time.June, 28, 0, 0, 0, 0, time.UTC)},
+		{1436, Ramadan, time.Date(2015, time.June, 18, 0, 0, 0, 0, time.UTC)},
+		{1436, Shawwal, time.Date(2015, time.July, 17, 0, 0, 0, 0, time.UTC)},
+		{1437, Ramadan, time.Date(2016, time.June, 6, 0, 0, 0, 0, time.UTC)},
+		{1438, Muharram, time.Date(2016, time.October, 2, 0, 0, 0, 0, time.UTC)},
+		{1439, Muharram, time.Date(2017, time.September, 21, 0, 0, 0, 0, time.UTC)},
+		{1439, Ramadan, time.Date(2018, time.May, 16, 0, 0, 0, 0, time.UTC)},
+		{1440, Muharram, time.Date(2018, time.September, 11, 0, 0, 0, 0, time.UTC)},
+		{1440, Ramadan, time.Date(2019, time.May, 6, 0, 0, 0, 0, time.UTC)},
+		{1441, Muharram, time.Date(2019, time.August, 31, 0, 0, 0, 0, time.UTC)},
+		{1441, Ramadan, time.Date(2020, time.April, 24, 0, 0, 0, 0, time.UTC)},
+		{1442, Muharram, time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)},
+		{1442, Ramadan, time.Date(2021, time.April, 13, 0, 0, 0, 0, time.UTC)},
+		{1443, Muharram, time.Date(2021, time.August, 9, 0, 0, 0, 0, time.UTC)},
+		{1443, Ramadan, time.Date(2022, time.April, 2, 0, 0, 0, 0, time.UTC)},
+		{1444, Muharram, time.Date(2022, time.July, 30, 0, 0, 0, 0, time.UTC)},
+		{1444, Ramadan, time.Date(2023, time.March, 23, 0, 0, 0, 0, time.UTC)},
+		{1444, Shawwal, time.Date(2023, time.April, 21, 0, 0, 0, 0, time.UTC)},
+		{1444, DhulHijjah, time.Date(2023, time.June, 19, 0, 0, 0, 0, time.UTC)},
+		{1445, Muharram, time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC)},
+		{1445, Ramadan, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{1445, Shawwal, time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)},
+		{1445, DhulHijjah, time.Date(2024, time.June, 7, 0, 0, 0, 0, time.UTC)},
+		{1446, Muharram, time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC)},
+		{1446, Ramadan, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{1446, Shawwal, time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC)},
+		{1447, Muharram, time.Date(2025, time.June, 26, 0, 0, 0, 0, time.UTC)},
+		{1447, Ramadan, time.Date(2026, time.February, 18, 0, 0, 0, 0, time.UTC)},
+		{1448, Muharram, time.Date(2026, time.June, 16, 0, 0, 0, 0, time.UTC)},
+		{1448, Ramadan, time.Date(2027, time.February, 8, 0, 0, 0, 0, time.UTC)},
+		{1450, Ramadan, time.Date(2029, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{1500, Muharram, time.Date(2076, time.November, 28, 0, 0, 0, 0, time.UTC)},
+		{1599, DhulHijjah, time.Date(2173, time.November, 7, 0, 0, 0, 0, time.UTC)},
+	}
+
+	hc := HijriCalendar{Method: UmmAlQura}
+	for _, tt := range tests {
+		if got := hc.MonthStart(tt.year, tt.month); !got.Equal(tt.start) {
+			t.Errorf("Umm al-Qura %d/%d starts on %s, want %s",
+				tt.year, tt.month, got.Format("2006-01-02"), tt.start.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestUmmAlQuraTable(t *testing.T) {
+	// every month of the table has 29 or 30 days and the computed months
+	// after it follow on
+	hc := HijriCalendar{Method: UmmAlQura}
+	for y := UmmAlQuraMinYear; y <= UmmAlQuraMaxYear+1; y++ {
+		for m := 1; m <= 12; m++ {
+			ny, nm := nextHijriMonth(y, m)
+			days := int(hc.MonthStart(ny, nm).Sub(hc.MonthStart(y, m)).Hours() / 24)
+			if days != 29 && days != 30 {
+				t.Errorf("Umm al-Qura %d/%d has %d days, want 29 or 30", y, m, days)
+			}
+		}
+	}
+
+	day := time.Date(2015, time.June, 18, 0, 0, 0, 0, time.UTC)
+	if h := hc.FromGregorian(day); h != (HijriDate{Year: 1436, Month: Ramadan, Day: 1}) {
+		t.Errorf("Umm al-Qura 2015-06-18 is %+v, want 1 Ramadan 1436", h)
+	}
+}
@@ -0,0 +1,161 @@
+package bizcal
+
+import (
+	"sort"
+	"time"
+)
+
+//Effective years of Hong Kong general holidays
+var (
+	HKSARHolidaysHK     = Effective{From: 1997}
+	BuddhasBirthdayHK   = Effective{From: 1999}
+	LunarNewYearsEveHK  = Effective{To: 2011}
+	NationalDaySecondHK = Effective{From: 1997, To: 1998}
+)
+
+//hkOneOffs lists the one-off general holidays
+var hkOneOffs = map[time.Time]string{
+	time.Date(1997, time.July, 2, 0, 0, 0, 0, time.UTC):      "Day following HKSAR Establishment Day",
+	time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC): "Millennium Holiday",
+	time.Date(2015, time.September, 3, 0, 0, 0, 0, time.UTC): "Sino-Japanese War Victory Day",
+}
+
+//observeSundays moves the holidays of a year falling on a Sunday
+//to the next day that is neither a Sunday nor a holiday
+func observeSundays(hs map[time.Time]string) {
+	days := make([]time.Time, 0, len(hs))
+	for day := range hs {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	for _, day := range days {
+		if day.Weekday() != time.Sunday {
+			continue
+		}
+
+		next := day.AddDate(0, 0, 1)
+		for _, ok := hs[next]; ok || next.Weekday() == time.Sunday; _, ok = hs[next] {
+			next = next.AddDate(0, 0, 1)
+		}
+		hs[next] = hs[day] + " (observed)"
+	}
+}
+
+//HKCal for Hong Kong general holidays, lunar dates are reckoned in China time
+//Rules are those in force since the handover, holidays falling on a Sunday
+//move to the next day that is not a holiday
+//has all BasicCal methods
+type HKCal struct {
+	BasicCal
+}
+
+//lunar returns the Gregorian day of a lunar date of a year
+func (cal HKCal) lunar(y int, month int, day int) time.Time {
+	t, _ := LunarToSolar(LunarDate{Year: y, Month: month, Day: day}, ChinaTime)
+	return t
+}
+
+//holidays lists the general holidays of a year with their observed days
+func (cal HKCal) holidays(y int) map[time.Time]string {
+	jan1 := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	em := jan1.AddDate(0, 0, cal.EasterMonday(y)-1)
+	ny := LunarNewYear(y, ChinaTime)
+
+	hs := map[time.Time]string{}
+	// a holiday coinciding with another moves to the next free day,
+	// Ching Ming on Easter Monday gives the Tuesday off
+	add := func(day time.Time, name string) {
+		for _, ok := hs[day]; ok; _, ok = hs[day] {
+			day = day.AddDate(0, 0, 1)
+		}
+		hs[day] = name
+	}
+
+	add(jan1, "New Year's Day")
+	add(ny, "Lunar New Year's Day")
+	add(ny.AddDate(0, 0, 1), "Second Day of Lunar New Year")
+	add(ny.AddDate(0, 0, 2), "Third Day of Lunar New Year")
+	add(em.AddDate(0, 0, -3), "Good Friday")
+	add(em.AddDate(0, 0, -2), "Day following Good Friday")
+	add(em, "Easter Monday")
+	add(dateKey(SolarTerm(y, 15).In(ChinaTime)), "Ching Ming Festival")
+	add(time.Date(y, time.May, 1, 0, 0, 0, 0, time.UTC), "Labour Day")
+	if BuddhasBirthdayHK.Covers(y) {
+		add(cal.lunar(y, 4, 8), "Buddha's Birthday")
+	}
+	add(cal.lunar(y, 5, 5), "Tuen Ng Festival")
+	if HKSARHolidaysHK.Covers(y) {
+		add(time.Date(y, time.July, 1, 0, 0, 0, 0, time.UTC), "HKSAR Establishment Day")
+	}
+	add(cal.lunar(y, 8, 16), "Day following Mid-Autumn Festival")
+	if HKSARHolidaysHK.Covers(y) {
+		add(time.Date(y, time.October, 1, 0, 0, 0, 0, time.UTC), "National Day")
+	}
+	if NationalDaySecondHK.Covers(y) {
+		add(time.Date(y, time.October, 2, 0, 0, 0, 0, time.UTC), "Day following National Day")
+	}
+	add(cal.lunar(y, 9, 9), "Chung Yeung Festival")
+	add(time.Date(y, time.December, 25, 0, 0, 0, 0, time.UTC), "Christmas Day")
+	add(time.Date(y, time.December, 26, 0, 0, 0, 0, time.UTC), "First weekday after Christmas Day")
+
+	// before 2012 a Lunar New Year's Day on a Sunday
+	// gave the eve off instead of the fourth day
+	eve := LunarNewYearsEveHK.Covers(y) && ny.Weekday() == time.Sunday
+	if eve {
+		delete(hs, ny)
+		hs[ny.AddDate(0, 0, -1)] = "Lunar New Year's Eve"
+	}
+
+	observeSundays(hs)
+
+	if eve {
+		hs[ny] = "Lunar New Year's Day"
+	}
+	for day, name := range hkOneOffs {
+		if day.Year() == y {
+			hs[day] = name
+		}
+	}
+
+	return hs
+}
+
+//HolidayName names Hong Kong general holidays
+func (cal HKCal) HolidayName(t time.Time) (string, bool) {
+	name, ok := cal.holidays(t.Year())[dateKey(t)]
+	return name, ok
+}
+
+//HKEXCal, calendar for the Hong Kong Stock Exchange
+//has all HKCal methods
+//It also satisfies BizCal interface
+type HKEXCal struct {
+	HKCal
+}
+
+//IsBusinessDay checks for business day according to HKEX Calendar
+func (cal HKEXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//EarlyClose returns the end of the morning session on the eves of
+//Christmas, New Year and Lunar New Year, the only session of those days
+func (cal HKEXCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	next := dateKey(t).AddDate(0, 0, 1)
+	if (m == time.December && (d == 24 || d == 31)) || next.Equal(LunarNewYear(next.Year(), ChinaTime)) {
+		return closeAt(t, 12, 0, HongKong), true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,91 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Holiday is a weekday on which a calendar is closed
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+//HolidayNamer is implemented by calendars that can name their holidays
+type HolidayNamer interface {
+	HolidayName(t time.Time) (string, bool)
+}
+
+//WorkingWeekender is implemented by calendars where the government can make
+//a Saturday or Sunday a working day in exchange for a day off around a holiday
+type WorkingWeekender interface {
+	IsWorkingWeekend(t time.Time) bool
+}
+
+//Holidays lists the holidays of a calendar from one date to another, both inclusive
+//weekends are skipped, unnamed closings are called "Holiday"
+func Holidays(cal BizCal, from, to time.Time) []Holiday {
+	var hs []Holiday
+
+	namer, _ := cal.(HolidayNamer)
+	for rt := truncDay(from); !rt.After(to); rt = rt.AddDate(0, 0, 1) {
+		if cal.IsWeekend(rt) || cal.IsBusinessDay(rt) {
+			continue
+		}
+
+		name := "Holiday"
+		if namer != nil {
+			if n, ok := namer.HolidayName(rt); ok {
+				name = n
+			}
+		}
+		hs = append(hs, Holiday{Date: rt, Name: name})
+	}
+
+	return hs
+}
+
+//HolidaysInYear lists the holidays of a calendar in one year
+func HolidaysInYear(cal BizCal, year int) []Holiday {
+	return Holidays(cal,
+		time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC))
+}
+
+//HolidayCal is a calendar of explicit holidays
+//weekends come from the base calendar, it satisfies BizCal
+type HolidayCal struct {
+	BaseCal
+	holidays map[time.Time]string
+}
+
+//NewHolidayCal returns an empty explicit-holiday calendar on a base calendar
+func NewHolidayCal(base BaseCal) *HolidayCal {
+	return &HolidayCal{BaseCal: base, holidays: map[time.Time]string{}}
+}
+
+//AddHoliday marks a day as a holiday, the time of day is ignored
+func (cal *HolidayCal) AddHoliday(t time.Time, name string) {
+	cal.holidays[dateKey(t)] = name
+}
+
+//IsBusinessDay checks for a weekday that is not an explicit holiday
+func (cal *HolidayCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.holidays[dateKey(t)]
+	return !ok
+}
+
+//HolidayName returns the name an explicit holiday was added with
+func (cal *HolidayCal) HolidayName(t time.Time) (string, bool) {
+	name, ok := cal.holidays[dateKey(t)]
+	return name, ok
+}
+
+//dateKey is the date of t at midnight UTC, used as a map key
+func dateKey(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,83 @@
+package bizcal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+/*
+iCalendar (RFC 5545) export
+
+Every holiday and special closing in a date range becomes an all-day
+VEVENT. The UID is built from the calendar name and the date only, so
+importing a newer export updates existing events instead of adding
+duplicates, even when a holiday is renamed.
+*/
+
+const icalDate = "20060102"
+
+//WriteICal writes the holidays of a calendar from one date to another,
+//both inclusive, as an RFC 5545 calendar named name
+func WriteICal(w io.Writer, name string, cal BizCal, from, to time.Time) error {
+	bw := bufio.NewWriter(w)
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	uidHost := strings.ToLower(strings.Map(uidRune, name)) + ".bizcal"
+
+	writeICalLine(bw, "BEGIN:VCALENDAR")
+	writeICalLine(bw, "VERSION:2.0")
+	writeICalLine(bw, "PRODID:-//genghongchen//bizcal//EN")
+	writeICalLine(bw, "CALSCALE:GREGORIAN")
+	writeICalLine(bw, "METHOD:PUBLISH")
+	writeICalLine(bw, "X-WR-CALNAME:"+icalEscape(name))
+
+	for _, h := range Holidays(cal, from, to) {
+		writeICalLine(bw, "BEGIN:VEVENT")
+		writeICalLine(bw, "UID:"+h.Date.Format(icalDate)+"@"+uidHost)
+		writeICalLine(bw, "DTSTAMP:"+stamp)
+		writeICalLine(bw, "DTSTART;VALUE=DATE:"+h.Date.Format(icalDate))
+		writeICalLine(bw, "DTEND;VALUE=DATE:"+h.Date.AddDate(0, 0, 1).Format(icalDate))
+		writeICalLine(bw, "SUMMARY:"+icalEscape(h.Name))
+		writeICalLine(bw, "DESCRIPTION:"+icalEscape(fmt.Sprintf("%s closed: %s", name, h.Name)))
+		writeICalLine(bw, "TRANSP:TRANSPARENT")
+		writeICalLine(bw, "END:VEVENT")
+	}
+
+	writeICalLine(bw, "END:VCALENDAR")
+
+	return bw.Flush()
+}
+
+//writeICalLine writes one content line, folded at 75 octets
+//without splitting a UTF-8 sequence
+func writeICalLine(w *bufio.Writer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		limit = 74
+	}
+	w.WriteString(line + "\r\n")
+}
+
+//icalEscape escapes a TEXT value
+func icalEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+//uidRune keeps letters, digits, dots and dashes in a UID
+func uidRune(r rune) rune {
+	if r == '-' || r == '.' || (r >= '0' && r <= '9') ||
+		(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+		return r
+	}
+
+	return '-'
+}
@@ -0,0 +1,100 @@
+package bizcal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeICalString(t *testing.T, name string, cal BizCal, from, to time.Time) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WriteICal(&buf, name, cal, from, to); err != nil {
+		t.Fatalf("WriteICal: %v", err)
+	}
+	return buf.String()
+}
+
+func TestWriteICal(t *testing.T) {
+	out := writeICalString(t, "NYSE", NYSECal{},
+		time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.July, 31, 0, 0, 0, 0, time.UTC))
+
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Errorf("WriteICal output is not one VCALENDAR:\n%s", out)
+	}
+	if n := strings.Count(out, "BEGIN:VEVENT"); n != 1 {
+		t.Errorf("WriteICal wrote %d events for July 2024, want 1", n)
+	}
+	for _, line := range []string{
+		"X-WR-CALNAME:NYSE",
+		"UID:20240704@nyse.bizcal",
+		"DTSTART;VALUE=DATE:20240704",
+		"DTEND;VALUE=DATE:20240705",
+		"SUMMARY:Independence Day",
+		"DESCRIPTION:NYSE closed: Independence Day",
+		"TRANSP:TRANSPARENT",
+	} {
+		if !strings.Contains(out, "\r\n"+line+"\r\n") {
+			t.Errorf("WriteICal output lacks %q:\n%s", line, out)
+		}
+	}
+
+	// an open federal holiday is not exported
+	out = writeICalString(t, "NYSE", NYSECal{},
+		time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.November, 30, 0, 0, 0, 0, time.UTC))
+	if strings.Contains(out, "Columbus Day") || strings.Contains(out, "Veterans Day") {
+		t.Errorf("WriteICal exported a day NYSE is open:\n%s", out)
+	}
+}
+
+func TestWriteICalUID(t *testing.T) {
+	// the UID depends on the calendar name and the date only
+	day := time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC)
+	a, b := NewHolidayCal(BasicCal{}), NewHolidayCal(BasicCal{})
+	a.AddHoliday(day, "Good Friday")
+	b.AddHoliday(day, "Closed, Good Friday")
+
+	uid := "\r\nUID:20240329@my-exchange.bizcal\r\n"
+	for _, cal := range []*HolidayCal{a, b} {
+		if out := writeICalString(t, "My Exchange", cal, day, day); !strings.Contains(out, uid) {
+			t.Errorf("WriteICal UID is not stable, want %q in:\n%s", uid, out)
+		}
+	}
+	if out := writeICalString(t, "My Exchange", b, day, day); !strings.Contains(out, `SUMMARY:Closed\, Good Friday`) {
+		t.Errorf("WriteICal did not escape the summary:\n%s", out)
+	}
+}
+
+func TestWriteICalFolding(t *testing.T) {
+	day := time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC)
+	cal := NewHolidayCal(BasicCal{})
+	cal.AddHoliday(day, strings.Repeat("Très long jour férié ", 10))
+
+	out := writeICalString(t, "Long", cal, day, day)
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("WriteICal line of %d octets, want at most 75: %q", len(line), line)
+		}
+	}
+}
+
+func TestWriteICalRoundTrip(t *testing.T) {
+	from, to := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	nyse := NYSECal{}
+	out := writeICalString(t, "NYSE", nyse, from, to)
+
+	cal, err := ParseICal(strings.NewReader(out), BasicCal{})
+	if err != nil {
+		t.Fatalf("ParseICal of WriteICal output: %v", err)
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(day) != nyse.IsBusinessDay(day) {
+			t.Errorf("round trip %s business day %v, want %v", day.Format("2006-01-02"), cal.IsBusinessDay(day), nyse.IsBusinessDay(day))
+		}
+		want, _ := nyse.HolidayName(day)
+		if got, _ := cal.HolidayName(day); got != want {
+			t.Errorf("round trip %s is %q, want %q", day.Format("2006-01-02"), got, want)
+		}
+	}
+}
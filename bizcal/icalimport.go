@@ -0,0 +1,583 @@
+package bizcal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+iCalendar (RFC 5545) import
+
+ParseICal reads the all-day VEVENTs of a published holiday schedule
+into a HolidayCal. DTSTART, DTEND, DURATION, SUMMARY, RRULE, EXDATE,
+UID and RECURRENCE-ID are used; date-time values keep only their date.
+An event with a RECURRENCE-ID replaces that occurrence of the recurring
+event with the same UID, overrides with RANGE=THISANDFUTURE are rejected.
+Recurring events without COUNT or UNTIL are expanded up to ICalHorizon.
+*/
+
+//ICalHorizon is the last year open-ended recurring events are expanded to
+var ICalHorizon = 2199
+
+//icalEvent is one VEVENT as read from the stream
+type icalEvent struct {
+	line       int
+	uid        string
+	start      time.Time
+	days       int
+	summary    string
+	rrule      string
+	exdates    []time.Time
+	status     string
+	recurrence time.Time
+}
+
+//ParseICal builds an explicit-holiday calendar from an iCalendar stream
+//weekends come from base, such as BasicCal{}
+func ParseICal(r io.Reader, base BaseCal) (*HolidayCal, error) {
+	lines, err := unfoldICal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*icalEvent
+	var ev *icalEvent
+	var end time.Time
+	var duration string
+	// depth counts the components open inside the VEVENT, such as VALARM
+	depth := 0
+
+	for i, line := range lines {
+		name, value, ok := splitICalLine(line)
+		if !ok {
+			return nil, fmt.Errorf("bizcal: ical line %d: malformed content line", i+1)
+		}
+
+		switch {
+		case ev == nil && name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			ev, end, duration, depth = &icalEvent{days: 1}, time.Time{}, "", 0
+		case ev == nil:
+			// outside VEVENT
+		case name == "BEGIN":
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case depth > 0:
+			// properties of a nested component
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if ev.start.IsZero() {
+				return nil, fmt.Errorf("bizcal: ical line %d: VEVENT without DTSTART", i+1)
+			}
+			switch {
+			case !end.IsZero() && duration != "":
+				return nil, fmt.Errorf("bizcal: ical line %d: VEVENT with both DTEND and DURATION", i+1)
+			case !end.IsZero():
+				ev.days = int(end.Sub(ev.start).Hours()/24 + 0.5)
+			case duration != "":
+				if ev.days, err = parseICalDuration(duration); err != nil {
+					return nil, fmt.Errorf("bizcal: ical line %d: %v", i+1, err)
+				}
+			}
+			if ev.days < 1 {
+				ev.days = 1
+			}
+			ev.line = i + 1
+			events = append(events, ev)
+			ev = nil
+		case name == "DTSTART":
+			if ev.start, err = parseICalDate(value); err != nil {
+				return nil, fmt.Errorf("bizcal: ical line %d: %v", i+1, err)
+			}
+		case name == "DTEND":
+			if end, err = parseICalDate(value); err != nil {
+				return nil, fmt.Errorf("bizcal: ical line %d: %v", i+1, err)
+			}
+		case name == "DURATION":
+			duration = value
+		case name == "UID":
+			ev.uid = value
+		case name == "RECURRENCE-ID":
+			if strings.Contains(strings.ToUpper(line[:len(line)-len(value)]), "RANGE=THISANDFUTURE") {
+				return nil, fmt.Errorf("bizcal: ical line %d: unsupported RECURRENCE-ID RANGE=THISANDFUTURE", i+1)
+			}
+			if ev.recurrence, err = parseICalDate(value); err != nil {
+				return nil, fmt.Errorf("bizcal: ical line %d: %v", i+1, err)
+			}
+		case name == "SUMMARY":
+			ev.summary = icalUnescape(value)
+		case name == "RRULE":
+			ev.rrule = value
+		case name == "STATUS":
+			ev.status = strings.ToUpper(value)
+		case name == "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				t, err := parseICalDate(v)
+				if err != nil {
+					return nil, fmt.Errorf("bizcal: ical line %d: %v", i+1, err)
+				}
+				ev.exdates = append(ev.exdates, t)
+			}
+		}
+	}
+
+	// an override takes the place of its occurrence of the recurring event
+	masters := map[string]*icalEvent{}
+	for _, ev := range events {
+		if ev.recurrence.IsZero() && ev.uid != "" {
+			masters[ev.uid] = ev
+		}
+	}
+	for _, ev := range events {
+		if master, ok := masters[ev.uid]; ok && !ev.recurrence.IsZero() {
+			master.exdates = append(master.exdates, ev.recurrence)
+		}
+	}
+
+	cal := NewHolidayCal(base)
+	for _, ev := range events {
+		if err := ev.addTo(cal); err != nil {
+			return nil, fmt.Errorf("bizcal: ical line %d: %v", ev.line, err)
+		}
+	}
+
+	return cal, nil
+}
+
+//unfoldICal reads content lines, joining folded continuation lines
+func unfoldICal(r io.Reader) ([]string, error) {
+	var lines []string
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, sc.Err()
+}
+
+//splitICalLine splits "NAME;PARAM=X:VALUE" into name and value, dropping parameters
+func splitICalLine(line string) (name, value string, ok bool) {
+	colon := -1
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		if line[i] == '"' {
+			quoted = !quoted
+		} else if line[i] == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return "", "", false
+	}
+
+	name = line[:colon]
+	if semi := strings.IndexByte(name, ';'); semi >= 0 {
+		name = name[:semi]
+	}
+
+	return strings.ToUpper(name), line[colon+1:], true
+}
+
+//parseICalDate parses a DATE or DATE-TIME value, keeping only the date
+func parseICalDate(v string) (time.Time, error) {
+	if len(v) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", v)
+	}
+
+	t, err := time.Parse(icalDate, v[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", v)
+	}
+
+	return t, nil
+}
+
+//parseICalDuration parses a DURATION value such as P1D, P2W or PT24H
+//into whole days, rounding a part day to the nearest day
+func parseICalDuration(v string) (int, error) {
+	s := strings.ToUpper(strings.TrimPrefix(v, "+"))
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	var hours, n int
+	digits, inTime := false, false
+	for _, c := range s[1:] {
+		switch {
+		case c >= '0' && c <= '9':
+			n, digits = n*10+int(c-'0'), true
+			continue
+		case c == 'T' && !inTime && !digits:
+			inTime = true
+			continue
+		case !digits:
+			return 0, fmt.Errorf("invalid duration %q", v)
+		case c == 'W' && !inTime:
+			hours += n * 7 * 24
+		case c == 'D' && !inTime:
+			hours += n * 24
+		case c == 'H' && inTime:
+			hours += n
+		case (c == 'M' || c == 'S') && inTime:
+			// minutes and seconds do not make a day
+		default:
+			return 0, fmt.Errorf("invalid duration %q", v)
+		}
+		n, digits = 0, false
+	}
+	if digits || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	return (hours + 12) / 24, nil
+}
+
+func icalUnescape(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+//addTo adds every day of every occurrence of the event to a calendar
+func (ev *icalEvent) addTo(cal *HolidayCal) error {
+	if ev.status == "CANCELLED" {
+		return nil
+	}
+
+	starts := []time.Time{ev.start}
+	if ev.rrule != "" {
+		rule, err := parseRRule(ev.rrule)
+		if err != nil {
+			return err
+		}
+		starts = rule.expand(ev.start)
+	}
+
+	excluded := map[time.Time]bool{}
+	for _, t := range ev.exdates {
+		excluded[t] = true
+	}
+
+	name := ev.summary
+	if name == "" {
+		name = "Holiday"
+	}
+
+	for _, s := range starts {
+		if excluded[s] {
+			continue
+		}
+		for i := 0; i < ev.days; i++ {
+			cal.AddHoliday(s.AddDate(0, 0, i), name)
+		}
+	}
+
+	return nil
+}
+
+//byDay is one BYDAY entry such as 3MO or -1FR, n is 0 when no ordinal is given
+type byDay struct {
+	n int
+	w time.Weekday
+}
+
+//rrule is the subset of RFC 5545 recurrence rules used by holiday schedules
+type rrule struct {
+	freq       string
+	interval   int
+	count      int
+	until      time.Time
+	byMonth    []int
+	byMonthDay []int
+	byDay      []byDay
+	bySetPos   []int
+}
+
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+func parseRRule(s string) (rrule, error) {
+	r := rrule{interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		eq := strings.IndexByte(part, '=')
+		if eq < 0 {
+			return r, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		key, val := strings.ToUpper(part[:eq]), strings.ToUpper(part[eq+1:])
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.freq = val
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(val)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("interval %d", r.interval)
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(val)
+		case "UNTIL":
+			r.until, err = parseICalDate(val)
+		case "BYMONTH":
+			r.byMonth, err = atoiList(val)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = atoiList(val)
+		case "BYSETPOS":
+			r.bySetPos, err = atoiList(val)
+		case "BYDAY":
+			for _, v := range strings.Split(val, ",") {
+				if len(v) < 2 {
+					return r, fmt.Errorf("invalid BYDAY %q", v)
+				}
+				w, ok := icalWeekdays[v[len(v)-2:]]
+				if !ok {
+					return r, fmt.Errorf("invalid BYDAY %q", v)
+				}
+				bd := byDay{w: w}
+				if len(v) > 2 {
+					if bd.n, err = strconv.Atoi(v[:len(v)-2]); err != nil {
+						return r, fmt.Errorf("invalid BYDAY %q", v)
+					}
+				}
+				r.byDay = append(r.byDay, bd)
+			}
+		case "WKST":
+			// weeks start on Monday, other starts are not needed for holidays
+		default:
+			return r, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+		if err != nil {
+			return r, fmt.Errorf("invalid RRULE %s: %v", key, err)
+		}
+	}
+
+	switch r.freq {
+	case "YEARLY", "MONTHLY", "WEEKLY", "DAILY":
+	default:
+		return r, fmt.Errorf("unsupported RRULE FREQ %q", r.freq)
+	}
+
+	return r, nil
+}
+
+func atoiList(s string) ([]int, error) {
+	var ns []int
+	for _, v := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+
+	return ns, nil
+}
+
+//expand lists the occurrences of the rule starting at start
+func (r rrule) expand(start time.Time) []time.Time {
+	last := time.Date(ICalHorizon, time.December, 31, 0, 0, 0, 0, time.UTC)
+	if !r.until.IsZero() && r.until.Before(last) {
+		last = r.until
+	}
+
+	var out []time.Time
+	for period := start; !period.After(last); period = r.nextPeriod(period, start) {
+		for _, t := range r.setPos(r.candidates(period, start)) {
+			if t.Before(start) || t.After(last) {
+				continue
+			}
+			out = append(out, t)
+			if r.count > 0 && len(out) == r.count {
+				return out
+			}
+		}
+	}
+
+	return out
+}
+
+//nextPeriod steps to the start of the next year, month, week or day of the rule
+func (r rrule) nextPeriod(period, start time.Time) time.Time {
+	switch r.freq {
+	case "YEARLY":
+		return time.Date(period.Year()+r.interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case "MONTHLY":
+		return time.Date(period.Year(), period.Month()+time.Month(r.interval), 1, 0, 0, 0, 0, time.UTC)
+	case "WEEKLY":
+		return weekStart(period).AddDate(0, 0, 7*r.interval)
+	}
+
+	return period.AddDate(0, 0, r.interval)
+}
+
+//candidates lists the sorted occurrences within the period containing t
+func (r rrule) candidates(t, start time.Time) []time.Time {
+	var out []time.Time
+	y := t.Year()
+
+	switch r.freq {
+	case "YEARLY":
+		switch {
+		case len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0:
+			out = appendValidDate(out, y, start.Month(), start.Day())
+		case len(r.byMonth) == 0 && len(r.byMonthDay) == 0:
+			// BYDAY ordinals count within the year
+			out = r.byDayIn(out, time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(y+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+		default:
+			months := r.byMonth
+			if len(months) == 0 {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			}
+			for _, m := range months {
+				out = r.inMonth(out, y, time.Month(m), start)
+			}
+		}
+	case "MONTHLY":
+		if r.monthMatches(t.Month()) {
+			out = r.inMonth(out, y, t.Month(), start)
+		}
+	case "WEEKLY":
+		ws := weekStart(t)
+		for i := 0; i < 7; i++ {
+			d := ws.AddDate(0, 0, i)
+			if r.monthMatches(d.Month()) && ((len(r.byDay) == 0 && d.Weekday() == start.Weekday()) || r.weekdayMatches(d.Weekday())) {
+				out = append(out, d)
+			}
+		}
+	case "DAILY":
+		if r.monthMatches(t.Month()) && (len(r.byDay) == 0 || r.weekdayMatches(t.Weekday())) &&
+			(len(r.byMonthDay) == 0 || r.monthDayMatches(t)) {
+			out = append(out, t)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out
+}
+
+//inMonth adds the occurrences within one month
+func (r rrule) inMonth(out []time.Time, y int, m time.Month, start time.Time) []time.Time {
+	first := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	next := first.AddDate(0, 1, 0)
+
+	switch {
+	case len(r.byMonthDay) > 0:
+		for d := first; d.Before(next); d = d.AddDate(0, 0, 1) {
+			if r.monthDayMatches(d) && (len(r.byDay) == 0 || r.weekdayMatches(d.Weekday())) {
+				out = append(out, d)
+			}
+		}
+	case len(r.byDay) > 0:
+		out = r.byDayIn(out, first, next)
+	default:
+		out = appendValidDate(out, y, m, start.Day())
+	}
+
+	return out
+}
+
+//byDayIn adds the BYDAY matches from first up to but not including next
+func (r rrule) byDayIn(out []time.Time, first, next time.Time) []time.Time {
+	for _, bd := range r.byDay {
+		var all []time.Time
+		for d := first; d.Before(next); d = d.AddDate(0, 0, 1) {
+			if d.Weekday() == bd.w {
+				all = append(all, d)
+			}
+		}
+		switch {
+		case bd.n == 0:
+			out = append(out, all...)
+		case bd.n > 0 && bd.n <= len(all):
+			out = append(out, all[bd.n-1])
+		case bd.n < 0 && -bd.n <= len(all):
+			out = append(out, all[len(all)+bd.n])
+		}
+	}
+
+	return out
+}
+
+//setPos applies BYSETPOS to the occurrences of one period
+func (r rrule) setPos(ts []time.Time) []time.Time {
+	if len(r.bySetPos) == 0 {
+		return ts
+	}
+
+	var out []time.Time
+	for _, p := range r.bySetPos {
+		switch {
+		case p > 0 && p <= len(ts):
+			out = append(out, ts[p-1])
+		case p < 0 && -p <= len(ts):
+			out = append(out, ts[len(ts)+p])
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+
+	return out
+}
+
+func (r rrule) monthMatches(m time.Month) bool {
+	if len(r.byMonth) == 0 {
+		return true
+	}
+	for _, bm := range r.byMonth {
+		if time.Month(bm) == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r rrule) weekdayMatches(w time.Weekday) bool {
+	for _, bd := range r.byDay {
+		if bd.w == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r rrule) monthDayMatches(t time.Time) bool {
+	days := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, md := range r.byMonthDay {
+		if md == t.Day() || (md < 0 && days+md+1 == t.Day()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//appendValidDate adds a date unless it does not exist, such as February 30
+func appendValidDate(out []time.Time, y int, m time.Month, d int) []time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if t.Month() != m {
+		return out
+	}
+
+	return append(out, t)
+}
+
+//weekStart returns the Monday starting the week of t
+func weekStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
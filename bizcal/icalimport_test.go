@@ -0,0 +1,173 @@
+package bizcal
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseICalString(t *testing.T, s string) *HolidayCal {
+	t.Helper()
+	cal, err := ParseICal(strings.NewReader(strings.ReplaceAll(s, "\n", "\r\n")), BasicCal{})
+	if err != nil {
+		t.Fatalf("ParseICal: %v", err)
+	}
+	return cal
+}
+
+func TestParseICal(t *testing.T) {
+	cal := parseICalString(t, `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:newyear
+DTSTART;VALUE=DATE:20240101
+DTEND;VALUE=DATE:20240102
+SUMMARY:New Year's Day
+END:VEVENT
+BEGIN:VEVENT
+UID:thanksgiving
+DTSTART;VALUE=DATE:20241128
+RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=3
+SUMMARY:Thanksgiving\, and the day after
+DURATION:P2D
+END:VEVENT
+END:VCALENDAR
+`)
+
+	tests := []struct {
+		day  time.Time
+		want string
+	}{
+		{time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.November, 28, 0, 0, 0, 0, time.UTC), "Thanksgiving, and the day after"},
+		{time.Date(2024, time.November, 29, 0, 0, 0, 0, time.UTC), "Thanksgiving, and the day after"},
+		{time.Date(2025, time.November, 28, 0, 0, 0, 0, time.UTC), "Thanksgiving, and the day after"},
+		{time.Date(2026, time.November, 26, 0, 0, 0, 0, time.UTC), "Thanksgiving, and the day after"},
+		{time.Date(2027, time.November, 25, 0, 0, 0, 0, time.UTC), ""},
+	}
+	for _, tt := range tests {
+		name, _ := cal.HolidayName(tt.day)
+		if name != tt.want {
+			t.Errorf("%s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.want)
+		}
+	}
+}
+
+func TestParseICalDuration(t *testing.T) {
+	tests := map[string]int{"P1D": 1, "P2W": 14, "PT24H": 1, "P1DT12H": 2, "PT1H": 0, "P1W2D": 9}
+	for v, want := range tests {
+		if got, err := parseICalDuration(v); err != nil || got != want {
+			t.Errorf("parseICalDuration(%q) = %d, %v, want %d", v, got, err, want)
+		}
+	}
+	for _, v := range []string{"", "P", "1D", "-P1D", "PD", "P1H", "PT1D", "P1", "P1DT"} {
+		if _, err := parseICalDuration(v); err == nil {
+			t.Errorf("parseICalDuration(%q) succeeded, want an error", v)
+		}
+	}
+}
+
+func TestParseICalRecurrenceID(t *testing.T) {
+	// the 2025 holiday moves to Friday and the 2026 one is cancelled
+	cal := parseICalString(t, `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:founders
+DTSTART;VALUE=DATE:20240603
+RRULE:FREQ=YEARLY;BYMONTH=6;BYDAY=1MO;COUNT=4
+SUMMARY:Founders' Day
+END:VEVENT
+BEGIN:VEVENT
+UID:founders
+RECURRENCE-ID;VALUE=DATE:20250602
+DTSTART;VALUE=DATE:20250606
+SUMMARY:Founders' Day (moved)
+END:VEVENT
+BEGIN:VEVENT
+UID:founders
+RECURRENCE-ID;VALUE=DATE:20260601
+DTSTART;VALUE=DATE:20260601
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`)
+
+	tests := []struct {
+		day  time.Time
+		want string
+	}{
+		{time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC), "Founders' Day"},
+		{time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2025, time.June, 6, 0, 0, 0, 0, time.UTC), "Founders' Day (moved)"},
+		{time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2027, time.June, 7, 0, 0, 0, 0, time.UTC), "Founders' Day"},
+	}
+	for _, tt := range tests {
+		name, _ := cal.HolidayName(tt.day)
+		if name != tt.want {
+			t.Errorf("%s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.want)
+		}
+	}
+}
+
+func TestParseICalAlarm(t *testing.T) {
+	// the properties of a VALARM belong to the alarm, not the holiday
+	cal := parseICalString(t, `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:newyear
+DTSTART;VALUE=DATE:20240101
+DTEND;VALUE=DATE:20240102
+SUMMARY:New Year's Day
+BEGIN:VALARM
+ACTION:DISPLAY
+SUMMARY:Reminder
+DURATION:PT15M
+REPEAT:1
+TRIGGER:-PT15M
+END:VALARM
+END:VEVENT
+END:VCALENDAR
+`)
+
+	if name, _ := cal.HolidayName(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)); name != "New Year's Day" {
+		t.Errorf("2024-01-01 is %q, want New Year's Day", name)
+	}
+	if name, ok := cal.HolidayName(time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("2024-01-02 is %q, want no holiday", name)
+	}
+}
+
+func TestParseICalErrors(t *testing.T) {
+	tests := map[string]string{
+		"DTEND and DURATION": `BEGIN:VEVENT
+DTSTART;VALUE=DATE:20240101
+DTEND;VALUE=DATE:20240102
+DURATION:P1D
+END:VEVENT
+`,
+		"bad DURATION": `BEGIN:VEVENT
+DTSTART;VALUE=DATE:20240101
+DURATION:1D
+END:VEVENT
+`,
+		"THISANDFUTURE": `BEGIN:VEVENT
+UID:x
+RECURRENCE-ID;RANGE=THISANDFUTURE;VALUE=DATE:20250101
+DTSTART;VALUE=DATE:20250102
+END:VEVENT
+`,
+		"no DTSTART": `BEGIN:VEVENT
+SUMMARY:Holiday
+END:VEVENT
+`,
+		"bad RRULE": `BEGIN:VEVENT
+DTSTART;VALUE=DATE:20240101
+RRULE:FREQ=HOURLY
+END:VEVENT
+`,
+	}
+	for name, s := range tests {
+		if _, err := ParseICal(strings.NewReader(s), BasicCal{}); err == nil {
+			t.Errorf("ParseICal with %s succeeded, want an error", name)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//taseMondayFriday is the first day of the Monday to Friday trading week,
+//the exchange traded Sunday to Thursday before
+var taseMondayFriday = time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+//taseOneOffs lists the election days the exchange closed for
+var taseOneOffs = map[time.Time]string{
+	time.Date(2015, time.March, 17, 0, 0, 0, 0, time.UTC):     "Election Day",
+	time.Date(2019, time.April, 9, 0, 0, 0, 0, time.UTC):      "Election Day",
+	time.Date(2019, time.September, 17, 0, 0, 0, 0, time.UTC): "Election Day",
+	time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC):      "Election Day",
+	time.Date(2021, time.March, 23, 0, 0, 0, 0, time.UTC):     "Election Day",
+	time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC):   "Election Day",
+}
+
+//TASECal, calendar for the Tel Aviv Stock Exchange
+//The weekend is Saturday and Sunday since January 5th 2026,
+//Friday and Saturday before
+//has all BasicCal methods
+//It also satisfies BizCal interface
+type TASECal struct {
+	BasicCal
+}
+
+//IsWeekend checks for the TASE weekend in force on a day
+func (cal TASECal) IsWeekend(t time.Time) bool {
+	w := t.Weekday()
+	if dateKey(t).Before(taseMondayFriday) {
+		return w == time.Friday || w == time.Saturday
+	}
+
+	return w == time.Saturday || w == time.Sunday
+}
+
+//IsWeekday checks for a day outside the TASE weekend
+func (cal TASECal) IsWeekday(t time.Time) bool {
+	return !(cal.IsWeekend(t))
+}
+
+//IsBusinessDay checks for business day according to TASE Calendar
+func (cal TASECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//IsIndependenceDay checks for Independence Day, the 5th of Iyyar
+//brought forward to Thursday when it falls on a Friday or Saturday,
+//and put off to Tuesday when it falls on a Monday
+func (cal TASECal) IsIndependenceDay(h HebrewDate, w time.Weekday) bool {
+	if h.Month != Iyyar {
+		return false
+	}
+
+	switch h.Day {
+	case 3, 4:
+		return w == time.Thursday
+	case 5:
+		return w != time.Friday && w != time.Saturday && w != time.Monday
+	case 6:
+		return w == time.Tuesday
+	}
+
+	return false
+}
+
+//HolidayName names TASE holidays, the festivals and their eves
+func (cal TASECal) HolidayName(t time.Time) (string, bool) {
+	h := GregorianToHebrew(t)
+	w := t.Weekday()
+
+	// Purim is Adar II in leap years
+	purim := Adar
+	if IsHebrewLeapYear(h.Year) {
+		purim = AdarII
+	}
+
+	switch {
+	case h.Month == purim && h.Day == 14:
+		return "Purim", true
+	case h.Month == Nisan && (h.Day == 14 || h.Day == 20):
+		return "Passover Eve", true
+	case h.Month == Nisan && (h.Day == 15 || h.Day == 21):
+		return "Passover", true
+	case cal.IsIndependenceDay(h, w):
+		return "Independence Day", true
+	case h.Month == Sivan && h.Day == 5:
+		return "Shavuot Eve", true
+	case h.Month == Sivan && h.Day == 6:
+		return "Shavuot", true
+	case h.Month == Av && ((h.Day == 9 && w != time.Saturday) || (h.Day == 10 && w == time.Sunday)):
+		// the fast is put off to Sunday from the Sabbath
+		return "Tisha B'Av", true
+	case h.Month == Elul && h.Day == 29:
+		return "Rosh Hashanah Eve", true
+	case h.Month == Tishri && (h.Day == 1 || h.Day == 2):
+		return "Rosh Hashanah", true
+	case h.Month == Tishri && h.Day == 9:
+		return "Yom Kippur Eve", true
+	case h.Month == Tishri && h.Day == 10:
+		return "Yom Kippur", true
+	case h.Month == Tishri && h.Day == 14:
+		return "Sukkot Eve", true
+	case h.Month == Tishri && h.Day == 15:
+		return "Sukkot", true
+	case h.Month == Tishri && h.Day == 21:
+		return "Simchat Torah Eve", true
+	case h.Month == Tishri && h.Day == 22:
+		return "Simchat Torah", true
+	}
+
+	if name, ok := taseOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
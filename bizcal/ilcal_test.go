@@ -0,0 +1,67 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTASEHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.March, 24, 0, 0, 0, 0, time.UTC), "Purim"},
+		{time.Date(2024, time.April, 22, 0, 0, 0, 0, time.UTC), "Passover Eve"},
+		{time.Date(2024, time.April, 23, 0, 0, 0, 0, time.UTC), "Passover"},
+		{time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC), "Rosh Hashanah Eve"},
+		{time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC), "Rosh Hashanah"},
+		{time.Date(2024, time.October, 12, 0, 0, 0, 0, time.UTC), "Yom Kippur"},
+		{time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC), "Election Day"},
+		// Independence Day, the 5th of Iyyar, put off from Monday in 2024,
+		// brought forward from Saturday in 2025 and on the day in 2026
+		{time.Date(2024, time.May, 14, 0, 0, 0, 0, time.UTC), "Independence Day"},
+		{time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC), "Independence Day"},
+		{time.Date(2025, time.May, 3, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2026, time.April, 22, 0, 0, 0, 0, time.UTC), "Independence Day"},
+		// Tisha B'Av put off from the Sabbath in 2025
+		{time.Date(2025, time.August, 3, 0, 0, 0, 0, time.UTC), "Tisha B'Av"},
+		{time.Date(2025, time.August, 2, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.August, 13, 0, 0, 0, 0, time.UTC), "Tisha B'Av"},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (TASECal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("TASE %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestTASEWeekend(t *testing.T) {
+	// Sunday to Thursday until January 2nd 2026, Monday to Friday from January 5th
+	tests := []struct {
+		day      time.Time
+		business bool
+	}{
+		{time.Date(2025, time.December, 28, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.January, 9, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, time.January, 11, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		if got := (TASECal{}).IsBusinessDay(tt.day); got != tt.business {
+			t.Errorf("TASECal.IsBusinessDay(%s) = %v, want %v", tt.day.Format("2006-01-02"), got, tt.business)
+		}
+	}
+
+	next := NextBusinessDay(TASECal{}, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("NextBusinessDay(TASECal, 2026-01-01) = %s, want %s", next.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+}
@@ -0,0 +1,221 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//inFestivalYear is the yearly part of one year's exchange holiday list,
+//the festivals and the state holidays the exchanges close for,
+//revision counts the amendments published after the yearly circular,
+//muhurat is the day of the Muhurat session as month and day and
+//session its open and close as hour and minute, both zero
+//until the exchanges announce the session
+type inFestivalYear struct {
+	revision int
+	closures map[[2]int]string
+	muhurat  [2]int
+	session  [2][2]int
+}
+
+//inFestivals is the embedded table of NSE and BSE yearly closures by year,
+//as month and day, years outside the table have the national holidays only
+//and are outside the YearRange of INCal
+var inFestivals = map[int]inFestivalYear{
+	2022: {
+		closures: map[[2]int]string{
+			{3, 1}:   "Mahashivratri",
+			{3, 18}:  "Holi",
+			{4, 14}:  "Dr. Baba Saheb Ambedkar Jayanti",
+			{4, 15}:  "Good Friday",
+			{5, 1}:   "Maharashtra Day",
+			{5, 3}:   "Id-ul-Fitr",
+			{8, 9}:   "Muharram",
+			{8, 31}:  "Ganesh Chaturthi",
+			{10, 5}:  "Dussehra",
+			{10, 24}: "Diwali Laxmi Pujan",
+			{10, 26}: "Diwali Balipratipada",
+			{11, 8}:  "Guru Nanak Jayanti",
+			{12, 25}: "Christmas",
+		},
+		muhurat: [2]int{10, 24},
+		session: [2][2]int{{18, 15}, {19, 15}},
+	},
+	2023: {
+		// Bakri Id moved from June 28th
+		revision: 1,
+		closures: map[[2]int]string{
+			{3, 7}:   "Holi",
+			{3, 30}:  "Ram Navami",
+			{4, 4}:   "Mahavir Jayanti",
+			{4, 7}:   "Good Friday",
+			{4, 14}:  "Dr. Baba Saheb Ambedkar Jayanti",
+			{5, 1}:   "Maharashtra Day",
+			{6, 29}:  "Bakri Id",
+			{9, 19}:  "Ganesh Chaturthi",
+			{10, 24}: "Dussehra",
+			{11, 14}: "Diwali Balipratipada",
+			{11, 27}: "Guru Nanak Jayanti",
+			{12, 25}: "Christmas",
+		},
+		muhurat: [2]int{11, 12},
+		session: [2][2]int{{18, 15}, {19, 15}},
+	},
+	2024: {
+		// the Ayodhya and election closures were added during the year
+		revision: 3,
+		closures: map[[2]int]string{
+			{1, 22}:  "Special Holiday",
+			{3, 8}:   "Mahashivratri",
+			{3, 25}:  "Holi",
+			{3, 29}:  "Good Friday",
+			{4, 11}:  "Id-ul-Fitr",
+			{4, 14}:  "Dr. Baba Saheb Ambedkar Jayanti",
+			{4, 17}:  "Ram Navami",
+			{5, 1}:   "Maharashtra Day",
+			{5, 20}:  "General Election",
+			{6, 17}:  "Bakri Id",
+			{7, 17}:  "Muharram",
+			{11, 1}:  "Diwali Laxmi Pujan",
+			{11, 15}: "Guru Nanak Jayanti",
+			{11, 20}: "Maharashtra Assembly Election",
+			{12, 25}: "Christmas",
+		},
+		muhurat: [2]int{11, 1},
+		session: [2][2]int{{18, 0}, {19, 0}},
+	},
+	2025: {
+		closures: map[[2]int]string{
+			{2, 26}:  "Mahashivratri",
+			{3, 14}:  "Holi",
+			{3, 31}:  "Id-ul-Fitr",
+			{4, 10}:  "Mahavir Jayanti",
+			{4, 14}:  "Dr. Baba Saheb Ambedkar Jayanti",
+			{4, 18}:  "Good Friday",
+			{5, 1}:   "Maharashtra Day",
+			{8, 27}:  "Ganesh Chaturthi",
+			{10, 21}: "Diwali Laxmi Pujan",
+			{10, 22}: "Diwali Balipratipada",
+			{11, 5}:  "Guru Nanak Jayanti",
+			{12, 25}: "Christmas",
+		},
+		muhurat: [2]int{10, 21},
+		session: [2][2]int{{13, 45}, {14, 45}},
+	},
+	2026: {
+		// the Muhurat session on Sunday, November 8th is not announced yet
+		closures: map[[2]int]string{
+			{3, 3}:   "Holi",
+			{3, 26}:  "Ram Navami",
+			{3, 31}:  "Mahavir Jayanti",
+			{4, 3}:   "Good Friday",
+			{4, 14}:  "Dr. Baba Saheb Ambedkar Jayanti",
+			{5, 1}:   "Maharashtra Day",
+			{5, 28}:  "Bakri Id",
+			{6, 26}:  "Muharram",
+			{9, 14}:  "Ganesh Chaturthi",
+			{10, 20}: "Dussehra",
+			{11, 10}: "Diwali Balipratipada",
+			{11, 24}: "Guru Nanak Jayanti",
+			{12, 25}: "Christmas",
+		},
+	},
+}
+
+//INCal for the holidays of the Indian exchanges, the fixed national
+//holidays plus the festivals and state holidays of the embedded yearly table
+//has all BasicCal methods
+type INCal struct {
+	BasicCal
+}
+
+//FestivalTableRevision returns the revision of the festival table of a year,
+//ok is false for years the table does not cover
+func (cal INCal) FestivalTableRevision(year int) (int, bool) {
+	f, ok := inFestivals[year]
+	return f.revision, ok
+}
+
+//YearRange returns the years of the festival table, outside them
+//only the three national holidays are known
+func (cal INCal) YearRange() (from, to int) {
+	from, to = MaxYear, MinYear
+	for y := range inFestivals {
+		if y < from {
+			from = y
+		}
+		if y > to {
+			to = y
+		}
+	}
+
+	return from, to
+}
+
+//HolidayName names Indian exchange holidays
+func (cal INCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+
+	switch {
+	case d == 26 && m == time.January:
+		return "Republic Day", true
+	case d == 15 && m == time.August:
+		return "Independence Day", true
+	case d == 2 && m == time.October:
+		return "Mahatma Gandhi Jayanti", true
+	}
+
+	if name, ok := inFestivals[y].closures[[2]int{int(m), d}]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//SpecialSession returns the Muhurat trading session held on Diwali
+func (cal INCal) SpecialSession(t time.Time) (SpecialSession, bool) {
+	y, m, d := t.Date()
+	f, ok := inFestivals[y]
+	if !ok || m != time.Month(f.muhurat[0]) || d != f.muhurat[1] {
+		return SpecialSession{}, false
+	}
+
+	return SpecialSession{
+		Name:  "Muhurat Trading",
+		Open:  closeAt(t, f.session[0][0], f.session[0][1], Kolkata),
+		Close: closeAt(t, f.session[1][0], f.session[1][1], Kolkata),
+	}, true
+}
+
+//NSECal, calendar for the National Stock Exchange of India
+//has all INCal methods
+//It also satisfies BizCal interface
+type NSECal struct {
+	INCal
+}
+
+//IsBusinessDay checks for business day according to NSE Calendar
+func (cal NSECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//BSECal, calendar for BSE, same holidays as NSE
+//has all INCal methods
+//It also satisfies BizCal interface
+type BSECal struct {
+	INCal
+}
+
+//IsBusinessDay checks for business day according to BSE Calendar
+func (cal BSECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
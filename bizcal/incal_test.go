@@ -0,0 +1,87 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestINYearRange(t *testing.T) {
+	if from, to := YearRange(NSECal{}); from != 2022 || to != 2026 {
+		t.Errorf("YearRange(NSECal) = %d to %d, want 2022 to 2026", from, to)
+	}
+	if err := CheckYear(BSECal{}, 2027); err == nil {
+		t.Errorf("CheckYear(BSECal, 2027) succeeded, want an error")
+	}
+}
+
+func TestINHolidayName(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.January, 26, 0, 0, 0, 0, time.UTC), "Republic Day"},
+		{time.Date(2024, time.January, 22, 0, 0, 0, 0, time.UTC), "Special Holiday"},
+		{time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC), "General Election"},
+		{time.Date(2025, time.August, 15, 0, 0, 0, 0, time.UTC), "Independence Day"},
+		{time.Date(2025, time.October, 2, 0, 0, 0, 0, time.UTC), "Mahatma Gandhi Jayanti"},
+		{time.Date(2026, time.April, 14, 0, 0, 0, 0, time.UTC), "Dr. Baba Saheb Ambedkar Jayanti"},
+		{time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC), "Maharashtra Day"},
+		{time.Date(2026, time.November, 10, 0, 0, 0, 0, time.UTC), "Diwali Balipratipada"},
+		{time.Date(2023, time.June, 28, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2023, time.June, 29, 0, 0, 0, 0, time.UTC), "Bakri Id"},
+	}
+	for _, tt := range tests {
+		if name, _ := (NSECal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("NSECal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+		if (BSECal{}).IsBusinessDay(tt.day) != (tt.name == "") {
+			t.Errorf("BSECal %s business day %v, want %v", tt.day.Format("2006-01-02"), !(tt.name == ""), tt.name == "")
+		}
+	}
+}
+
+func TestINMuhurat(t *testing.T) {
+	tests := []struct {
+		day         time.Time
+		open, close string
+	}{
+		{time.Date(2023, time.November, 12, 0, 0, 0, 0, time.UTC), "18:15", "19:15"},
+		{time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC), "18:00", "19:00"},
+		{time.Date(2025, time.October, 21, 0, 0, 0, 0, time.UTC), "13:45", "14:45"},
+	}
+	for _, tt := range tests {
+		s, ok := (NSECal{}).SpecialSession(tt.day)
+		if !ok {
+			t.Errorf("NSECal %s has no special session, want Muhurat Trading", tt.day.Format("2006-01-02"))
+			continue
+		}
+		if s.Name != "Muhurat Trading" || s.Open.In(Kolkata).Format("15:04") != tt.open || s.Close.In(Kolkata).Format("15:04") != tt.close {
+			t.Errorf("NSECal %s session %s %s to %s, want Muhurat Trading %s to %s", tt.day.Format("2006-01-02"),
+				s.Name, s.Open.In(Kolkata).Format("15:04"), s.Close.In(Kolkata).Format("15:04"), tt.open, tt.close)
+		}
+	}
+
+	// the 2026 session is not announced yet
+	for _, day := range []time.Time{
+		time.Date(2026, time.November, 8, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.October, 22, 0, 0, 0, 0, time.UTC),
+	} {
+		if _, ok := (NSECal{}).SpecialSession(day); ok {
+			t.Errorf("NSECal %s has a special session, want none", day.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestINFestivalTableRevision(t *testing.T) {
+	tests := []struct {
+		year, rev int
+		ok        bool
+	}{
+		{2022, 0, true}, {2023, 1, true}, {2024, 3, true}, {2026, 0, true}, {2027, 0, false},
+	}
+	for _, tt := range tests {
+		if rev, ok := (NSECal{}).FestivalTableRevision(tt.year); rev != tt.rev || ok != tt.ok {
+			t.Errorf("FestivalTableRevision(%d) = %d, %v, want %d, %v", tt.year, rev, ok, tt.rev, tt.ok)
+		}
+	}
+}
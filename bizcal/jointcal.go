@@ -0,0 +1,68 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//JointCal, calendar whose business days are business days in every one
+//of its calendars, a weekend in any calendar is a weekend
+//It also satisfies BizCal interface
+type JointCal struct {
+	BasicCal
+	Cals []BizCal
+}
+
+//NewJointCal joins calendars
+func NewJointCal(cals ...BizCal) JointCal {
+	return JointCal{Cals: cals}
+}
+
+//IsWeekend checks if a day is a weekend in any of the calendars
+func (cal JointCal) IsWeekend(t time.Time) bool {
+	for _, c := range cal.Cals {
+		if c.IsWeekend(t) {
+			return true
+		}
+	}
+
+	return len(cal.Cals) == 0 && cal.BasicCal.IsWeekend(t)
+}
+
+//IsWeekday checks if a day is a weekday in all of the calendars
+func (cal JointCal) IsWeekday(t time.Time) bool {
+	return !cal.IsWeekend(t)
+}
+
+//IsBusinessDay checks if a day is a business day in all of the calendars
+func (cal JointCal) IsBusinessDay(t time.Time) bool {
+	for _, c := range cal.Cals {
+		if !c.IsBusinessDay(t) {
+			return false
+		}
+	}
+
+	return !cal.IsWeekend(t)
+}
+
+//HolidayName names the holiday from the first calendar that is closed
+func (cal JointCal) HolidayName(t time.Time) (string, bool) {
+	for _, c := range cal.Cals {
+		if c.IsBusinessDay(t) || c.IsWeekend(t) {
+			continue
+		}
+		if namer, ok := c.(HolidayNamer); ok {
+			if name, ok := namer.HolidayName(t); ok {
+				return name, true
+			}
+		}
+		return "Holiday", true
+	}
+
+	return "", false
+}
+
+//RollDeadline moves a due date that falls on a weekend or holiday of any
+//of the calendars forward to the next day that is a business day in all of them
+func RollDeadline(t time.Time, cals ...BizCal) time.Time {
+	return AdjForBusinessDay(NewJointCal(cals...), t)
+}
@@ -0,0 +1,310 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Japanese national holidays
+var (
+	NationalHolidaysJP      = Effective{From: 1949}
+	RespectForAgedDayJP     = Effective{From: 1966}
+	SportsDayJP             = Effective{From: 1966}
+	NationalFoundationDayJP = Effective{From: 1967}
+	CitizensHolidayJP       = Effective{From: 1986}
+	MarineDayJP             = Effective{From: 1996}
+	HappyMondayJP           = Effective{From: 2000}
+	HappyMondayMarineJP     = Effective{From: 2003}
+	ShowaDayJP              = Effective{From: 2007}
+	SubstituteRunJP         = Effective{From: 2007}
+	MountainDayJP           = Effective{From: 2016}
+)
+
+//jpSubstituteHolidayStart is the day substitute holidays were introduced
+var jpSubstituteHolidayStart = time.Date(1973, time.April, 12, 0, 0, 0, 0, time.UTC)
+
+//jpOneOffs lists the one-off national holidays
+var jpOneOffs = map[time.Time]string{
+	time.Date(1959, time.April, 10, 0, 0, 0, 0, time.UTC):    "Wedding of Crown Prince Akihito",
+	time.Date(1989, time.February, 24, 0, 0, 0, 0, time.UTC): "Funeral of Emperor Showa",
+	time.Date(1990, time.November, 12, 0, 0, 0, 0, time.UTC): "Enthronement Ceremony",
+	time.Date(1993, time.June, 9, 0, 0, 0, 0, time.UTC):      "Wedding of Crown Prince Naruhito",
+	time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC):       "Accession of Emperor Naruhito",
+	time.Date(2019, time.October, 22, 0, 0, 0, 0, time.UTC):  "Enthronement Ceremony",
+}
+
+//jpMoved lists the holidays moved for the Tokyo Olympics by year
+//as month and day, replacing the usual date
+var jpMoved = map[int]map[string][2]int{
+	2020: {
+		"Marine Day":   {7, 23},
+		"Sports Day":   {7, 24},
+		"Mountain Day": {8, 10},
+	},
+	2021: {
+		"Marine Day":   {7, 22},
+		"Sports Day":   {7, 23},
+		"Mountain Day": {8, 8},
+	},
+}
+
+//JPCal for all Japanese holidays
+//has all BasicCal methods
+type JPCal struct {
+	BasicCal
+}
+
+//moved checks for a holiday moved away from its usual date in a year
+//and reports whether the day is the moved date
+func (cal JPCal) moved(name string, y int, m time.Month, d int) (bool, bool) {
+	md, ok := jpMoved[y][name]
+	if !ok {
+		return false, false
+	}
+
+	return true, m == time.Month(md[0]) && d == md[1]
+}
+
+//IsVernalEquinoxDay checks for Vernal Equinox Day,
+//the day of the March equinox in Tokyo
+func (cal JPCal) IsVernalEquinoxDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.March && d == MarchEquinox(y).In(Tokyo).Day()
+}
+
+//IsAutumnalEquinoxDay checks for Autumnal Equinox Day,
+//the day of the September equinox in Tokyo
+func (cal JPCal) IsAutumnalEquinoxDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.September && d == SeptemberEquinox(y).In(Tokyo).Day()
+}
+
+//IsComingOfAgeDay checks for Coming of Age Day
+func (cal JPCal) IsComingOfAgeDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if HappyMondayJP.Covers(y) {
+		// second Monday of January
+		return (d >= 8 && d <= 14) && w == time.Monday && m == time.January
+	}
+
+	return d == 15 && m == time.January
+}
+
+//IsEmperorsBirthday checks for the Emperor's Birthday of the reigning emperor
+func (cal JPCal) IsEmperorsBirthday(y int, m time.Month, d int, w time.Weekday) bool {
+	switch {
+	case y <= 1988:
+		return d == 29 && m == time.April
+	case y <= 2018:
+		return d == 23 && m == time.December
+	case y == 2019:
+		// no Emperor's Birthday in the year of the succession
+		return false
+	}
+
+	return d == 23 && m == time.February
+}
+
+//IsMarineDay checks for Marine Day
+func (cal JPCal) IsMarineDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !MarineDayJP.Covers(y) {
+		return false
+	}
+	if moved, ok := cal.moved("Marine Day", y, m, d); moved {
+		return ok
+	}
+	if HappyMondayMarineJP.Covers(y) {
+		// third Monday of July
+		return (d >= 15 && d <= 21) && w == time.Monday && m == time.July
+	}
+
+	return d == 20 && m == time.July
+}
+
+//IsMountainDay checks for Mountain Day
+func (cal JPCal) IsMountainDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !MountainDayJP.Covers(y) {
+		return false
+	}
+	if moved, ok := cal.moved("Mountain Day", y, m, d); moved {
+		return ok
+	}
+
+	return d == 11 && m == time.August
+}
+
+//IsRespectForAgedDay checks for Respect for the Aged Day
+func (cal JPCal) IsRespectForAgedDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !RespectForAgedDayJP.Covers(y) {
+		return false
+	}
+	if HappyMondayMarineJP.Covers(y) {
+		// third Monday of September
+		return (d >= 15 && d <= 21) && w == time.Monday && m == time.September
+	}
+
+	return d == 15 && m == time.September
+}
+
+//IsSportsDay checks for Sports Day, called Health and Sports Day until 2019
+func (cal JPCal) IsSportsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if !SportsDayJP.Covers(y) {
+		return false
+	}
+	if moved, ok := cal.moved("Sports Day", y, m, d); moved {
+		return ok
+	}
+	if HappyMondayJP.Covers(y) {
+		// second Monday of October
+		return (d >= 8 && d <= 14) && w == time.Monday && m == time.October
+	}
+
+	return d == 10 && m == time.October
+}
+
+//nationalHoliday names the national holiday a day falls on
+//without substitute and citizen's holidays
+func (cal JPCal) nationalHoliday(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	if !NationalHolidaysJP.Covers(y) {
+		return "", false
+	}
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsComingOfAgeDay(y, m, d, w):
+		return "Coming of Age Day", true
+	case NationalFoundationDayJP.Covers(y) && d == 11 && m == time.February:
+		return "National Foundation Day", true
+	case cal.IsEmperorsBirthday(y, m, d, w):
+		return "Emperor's Birthday", true
+	case cal.IsVernalEquinoxDay(y, m, d, w):
+		return "Vernal Equinox Day", true
+	case ShowaDayJP.Covers(y) && d == 29 && m == time.April:
+		return "Showa Day", true
+	case y >= 1989 && d == 29 && m == time.April:
+		return "Greenery Day", true
+	case d == 3 && m == time.May:
+		return "Constitution Memorial Day", true
+	case ShowaDayJP.Covers(y) && d == 4 && m == time.May:
+		return "Greenery Day", true
+	case d == 5 && m == time.May:
+		return "Children's Day", true
+	case cal.IsMarineDay(y, m, d, w):
+		return "Marine Day", true
+	case cal.IsMountainDay(y, m, d, w):
+		return "Mountain Day", true
+	case cal.IsRespectForAgedDay(y, m, d, w):
+		return "Respect for the Aged Day", true
+	case cal.IsAutumnalEquinoxDay(y, m, d, w):
+		return "Autumnal Equinox Day", true
+	case cal.IsSportsDay(y, m, d, w) && y < 2020:
+		return "Health and Sports Day", true
+	case cal.IsSportsDay(y, m, d, w):
+		return "Sports Day", true
+	case d == 3 && m == time.November:
+		return "Culture Day", true
+	case d == 23 && m == time.November:
+		return "Labour Thanksgiving Day", true
+	}
+
+	if name, ok := jpOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//HolidayName names the Japanese public holiday a day falls on,
+//including substitute holidays for national holidays falling on
+//a Sunday and citizen's holidays sandwiched between two national holidays
+func (cal JPCal) HolidayName(t time.Time) (string, bool) {
+	if name, ok := cal.nationalHoliday(t); ok {
+		return name, true
+	}
+
+	y := t.Year()
+	w := t.Weekday()
+
+	if !dateKey(t).Before(jpSubstituteHolidayStart) && w != time.Sunday {
+		// since 2007 the first day after a run of holidays
+		// that includes a Sunday, before only the Monday
+		for prev := t.AddDate(0, 0, -1); ; prev = prev.AddDate(0, 0, -1) {
+			if _, ok := cal.nationalHoliday(prev); !ok {
+				break
+			}
+			if prev.Weekday() == time.Sunday {
+				return "Substitute Holiday", true
+			}
+			if !SubstituteRunJP.Covers(y) {
+				break
+			}
+		}
+	}
+
+	if CitizensHolidayJP.Covers(y) && w != time.Sunday {
+		_, before := cal.nationalHoliday(t.AddDate(0, 0, -1))
+		_, after := cal.nationalHoliday(t.AddDate(0, 0, 1))
+		if before && after {
+			return "Citizen's Holiday", true
+		}
+	}
+
+	return "", false
+}
+
+//JPSettleCal, calendar for Japanese settlement, the public holidays
+//plus the bank holidays of January 2nd and 3rd and December 31st
+//has all JPCal methods
+//It also satisfies BizCal interface
+type JPSettleCal struct {
+	JPCal
+}
+
+//IsBusinessDay checks for business day according to Japan Settlement Calendar
+func (cal JPSettleCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Japanese bank holidays
+func (cal JPSettleCal) HolidayName(t time.Time) (string, bool) {
+	_, m, d := t.Date()
+	if (m == time.January && (d == 2 || d == 3)) || (m == time.December && d == 31) {
+		return "Bank Holiday", true
+	}
+
+	return cal.JPCal.HolidayName(t)
+}
+
+//JPXCal, calendar for the Tokyo Stock Exchange and Japan Exchange Group
+//has all JPCal methods
+//It also satisfies BizCal interface
+type JPXCal struct {
+	JPCal
+}
+
+//IsBusinessDay checks for business day according to JPX Calendar
+func (cal JPXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names JPX holidays and special closings
+func (cal JPXCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+
+	// trading halted all day by a system failure
+	if y == 2020 && m == time.October && d == 1 {
+		return "Special closing", true
+	}
+
+	return JPSettleCal{cal.JPCal}.HolidayName(t)
+}
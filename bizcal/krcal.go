@@ -0,0 +1,218 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Korean public holidays
+//Substitute holidays came in 2014 for Seollal, Chuseok and Children's Day,
+//in 2021 for the national days and in 2023 for Buddha's Birthday and Christmas
+var (
+	SubstituteHolidaysKR = Effective{From: 2014}
+	SubstituteNationalKR = Effective{From: 2021}
+	SubstituteBuddhaKR   = Effective{From: 2023}
+	HangulDayKR          = Effective{From: 2013}
+)
+
+//krElections lists the presidential, National Assembly and local election days,
+//which are public holidays, from the 2006 local elections on
+var krElections = map[time.Time]string{
+	time.Date(2006, time.May, 31, 0, 0, 0, 0, time.UTC):      "Local Election Day",
+	time.Date(2007, time.December, 19, 0, 0, 0, 0, time.UTC): "Presidential Election Day",
+	time.Date(2008, time.April, 9, 0, 0, 0, 0, time.UTC):     "National Assembly Election Day",
+	time.Date(2010, time.June, 2, 0, 0, 0, 0, time.UTC):      "Local Election Day",
+	time.Date(2012, time.April, 11, 0, 0, 0, 0, time.UTC):    "National Assembly Election Day",
+	time.Date(2012, time.December, 19, 0, 0, 0, 0, time.UTC): "Presidential Election Day",
+	time.Date(2014, time.June, 4, 0, 0, 0, 0, time.UTC):      "Local Election Day",
+	time.Date(2016, time.April, 13, 0, 0, 0, 0, time.UTC):    "National Assembly Election Day",
+	time.Date(2017, time.May, 9, 0, 0, 0, 0, time.UTC):       "Presidential Election Day",
+	time.Date(2018, time.June, 13, 0, 0, 0, 0, time.UTC):     "Local Election Day",
+	time.Date(2020, time.April, 15, 0, 0, 0, 0, time.UTC):    "National Assembly Election Day",
+	time.Date(2022, time.March, 9, 0, 0, 0, 0, time.UTC):     "Presidential Election Day",
+	time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC):      "Local Election Day",
+	time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC):    "National Assembly Election Day",
+	time.Date(2025, time.June, 3, 0, 0, 0, 0, time.UTC):      "Presidential Election Day",
+	time.Date(2026, time.June, 3, 0, 0, 0, 0, time.UTC):      "Local Election Day",
+}
+
+//krTemporaryHolidays lists the temporary public holidays designated by the government
+var krTemporaryHolidays = map[time.Time]string{
+	time.Date(2015, time.August, 14, 0, 0, 0, 0, time.UTC):  "Temporary Holiday",
+	time.Date(2016, time.May, 6, 0, 0, 0, 0, time.UTC):      "Temporary Holiday",
+	time.Date(2017, time.October, 2, 0, 0, 0, 0, time.UTC):  "Temporary Holiday",
+	time.Date(2020, time.August, 17, 0, 0, 0, 0, time.UTC):  "Temporary Holiday",
+	time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC):  "Temporary Holiday",
+	time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC):  "Armed Forces Day",
+	time.Date(2025, time.January, 27, 0, 0, 0, 0, time.UTC): "Temporary Holiday",
+}
+
+//KRCal for Korean public holidays, lunar dates are reckoned in Korea time
+//has all BasicCal methods
+type KRCal struct {
+	BasicCal
+}
+
+//lunar returns the Gregorian day of a lunar date of a year
+func (cal KRCal) lunar(y int, month int, day int) time.Time {
+	t, _ := LunarToSolar(LunarDate{Year: y, Month: month, Day: day}, KoreaTime)
+	return t
+}
+
+//holidays lists the public holidays of a year with their substitutes
+func (cal KRCal) holidays(y int) map[time.Time]string {
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	seollal := LunarNewYear(y, KoreaTime)
+	chuseok := cal.lunar(y, 8, 15)
+
+	// a holiday is a run of days, sub tells which weekdays
+	// call for a substitute, a second holiday on a day always does
+	type holiday struct {
+		days []time.Time
+		name string
+		sub  map[time.Weekday]bool
+	}
+	sunday := map[time.Weekday]bool{time.Sunday: true}
+	weekend := map[time.Weekday]bool{time.Saturday: true, time.Sunday: true}
+	if !SubstituteHolidaysKR.Covers(y) {
+		sunday, weekend = nil, nil
+	}
+	national := weekend
+	if !SubstituteNationalKR.Covers(y) {
+		national = nil
+	}
+	buddha := weekend
+	if !SubstituteBuddhaKR.Covers(y) {
+		buddha = nil
+	}
+
+	list := []holiday{
+		{[]time.Time{date(time.January, 1)}, "New Year's Day", nil},
+		{[]time.Time{seollal.AddDate(0, 0, -1), seollal, seollal.AddDate(0, 0, 1)}, "Seollal", sunday},
+		{[]time.Time{date(time.March, 1)}, "Independence Movement Day", national},
+		{[]time.Time{date(time.May, 5)}, "Children's Day", weekend},
+		{[]time.Time{cal.lunar(y, 4, 8)}, "Buddha's Birthday", buddha},
+		{[]time.Time{date(time.June, 6)}, "Memorial Day", nil},
+		{[]time.Time{date(time.August, 15)}, "Liberation Day", national},
+		{[]time.Time{chuseok.AddDate(0, 0, -1), chuseok, chuseok.AddDate(0, 0, 1)}, "Chuseok", sunday},
+		{[]time.Time{date(time.October, 3)}, "National Foundation Day", national},
+		{[]time.Time{date(time.December, 25)}, "Christmas Day", buddha},
+	}
+	if HangulDayKR.Covers(y) {
+		list = append(list, holiday{[]time.Time{date(time.October, 9)}, "Hangul Day", national})
+	}
+
+	hs := map[time.Time]string{}
+	count := map[time.Time]int{}
+	for _, h := range list {
+		for _, day := range h.days {
+			if _, ok := hs[day]; !ok {
+				hs[day] = h.name
+			}
+			count[day]++
+		}
+	}
+
+	// the substitute is the first weekday after the holiday
+	// that is not a holiday itself, one per holiday,
+	// and holidays sharing a day share one substitute
+	claimed := map[time.Time]bool{}
+	for _, h := range list {
+		if h.sub == nil {
+			continue
+		}
+		due := false
+		for _, day := range h.days {
+			if h.sub[day.Weekday()] || (count[day] > 1 && !claimed[day]) {
+				due = true
+				claimed[day] = true
+				break
+			}
+		}
+		if !due {
+			continue
+		}
+		day := h.days[len(h.days)-1].AddDate(0, 0, 1)
+		for _, ok := hs[day]; ok || cal.IsWeekend(day); _, ok = hs[day] {
+			day = day.AddDate(0, 0, 1)
+		}
+		hs[day] = "Substitute Holiday"
+	}
+
+	for _, extra := range []map[time.Time]string{krElections, krTemporaryHolidays} {
+		for day, name := range extra {
+			if _, ok := hs[day]; !ok && day.Year() == y {
+				hs[day] = name
+			}
+		}
+	}
+
+	return hs
+}
+
+//YearRange returns the years the election and temporary holiday lists cover,
+//outside them those holidays are missing
+func (cal KRCal) YearRange() (from, to int) {
+	from, to = MaxYear, MinYear
+	for _, extra := range []map[time.Time]string{krElections, krTemporaryHolidays} {
+		for day := range extra {
+			if day.Year() < from {
+				from = day.Year()
+			}
+			if day.Year() > to {
+				to = day.Year()
+			}
+		}
+	}
+
+	return from, to
+}
+
+//HolidayName names Korean public holidays
+func (cal KRCal) HolidayName(t time.Time) (string, bool) {
+	name, ok := cal.holidays(t.Year())[dateKey(t)]
+	return name, ok
+}
+
+//KRXCal, calendar for the Korea Exchange
+//The exchange also closes on Labour Day and the last trading day of the year
+//has all KRCal methods
+//It also satisfies BizCal interface
+type KRXCal struct {
+	KRCal
+}
+
+//IsBusinessDay checks for business day according to KRX Calendar
+func (cal KRXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names KRX holidays
+func (cal KRXCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+
+	if d == 1 && m == time.May {
+		return "Labour Day", true
+	}
+	hs := cal.holidays(y)
+	if name, ok := hs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	// the last weekday of the year that is not a public holiday
+	last := time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for _, ok := hs[last]; ok || cal.IsWeekend(last); _, ok = hs[last] {
+		last = last.AddDate(0, 0, -1)
+	}
+	if dateKey(t).Equal(last) {
+		return "Year-end Closing", true
+	}
+
+	return "", false
+}
@@ -0,0 +1,64 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKRYearRange(t *testing.T) {
+	if from, to := YearRange(KRXCal{}); from != 2006 || to != 2026 {
+		t.Errorf("YearRange(KRXCal) = %d to %d, want 2006 to 2026", from, to)
+	}
+	if err := CheckYear(KRXCal{}, 2027); err == nil {
+		t.Errorf("CheckYear(KRXCal, 2027) succeeded, want an error")
+	}
+	if (KRXCal{}).IsBusinessDay(time.Date(2010, time.June, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("KRX 2010-06-02 open, want closed for the local elections")
+	}
+}
+
+func TestKRSubstituteHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// National Foundation Day on the first day of Chuseok
+		{time.Date(2017, time.October, 6, 0, 0, 0, 0, time.UTC), "Substitute Holiday"},
+		// Buddha's Birthday on a Saturday
+		{time.Date(2023, time.May, 29, 0, 0, 0, 0, time.UTC), "Substitute Holiday"},
+		// Chuseok from a Sunday, after Hangul Day is counted
+		{time.Date(2025, time.October, 8, 0, 0, 0, 0, time.UTC), "Substitute Holiday"},
+		{time.Date(2025, time.October, 9, 0, 0, 0, 0, time.UTC), "Hangul Day"},
+		// National Foundation Day on a Saturday
+		{time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC), "Substitute Holiday"},
+		// Buddha's Birthday on a Sunday before substitutes for it
+		{time.Date(2022, time.May, 9, 0, 0, 0, 0, time.UTC), ""},
+		// Christmas on a Sunday before substitutes for it
+		{time.Date(2022, time.December, 26, 0, 0, 0, 0, time.UTC), ""},
+	}
+	for _, tt := range tests {
+		if name, _ := (KRCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("KRCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestKRXYearEnd(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), "Year-end Closing"},
+		{time.Date(2023, time.December, 29, 0, 0, 0, 0, time.UTC), "Year-end Closing"},
+		{time.Date(2023, time.December, 28, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC), "Labour Day"},
+	}
+	for _, tt := range tests {
+		if name, _ := (KRXCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("KRXCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+	if name, ok := (KRCal{}).HolidayName(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("KRCal 2024-12-31 is %q, want no public holiday", name)
+	}
+}
@@ -0,0 +1,337 @@
+package bizcal
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+/*
+Chinese lunisolar calendar computed from astronomical new moons and solar terms
+New moons after Meeus, Astronomical Algorithms, 2nd ed., chapter 49,
+solar longitude after chapter 25, solstices after chapter 27
+
+Months begin on the day of the new moon in the calendar's time zone,
+the month holding the December solstice is the 11th month, and in a year
+of 13 months the first month without a principal solar term is the leap month
+*/
+
+//Years the lunar calendar is computed for, the astronomical series
+//are accurate to about a minute from 1000 to 3000
+const (
+	LunarMinYear = 1000
+	LunarMaxYear = 3000
+)
+
+//maxNewMoonSteps bounds the walks from a mean lunation estimate
+//to the actual new moon, the estimate is never a month off
+const maxNewMoonSteps = 4
+
+//Lunar calendar time zones, China uses UTC+8 and Korea UTC+9
+var (
+	ChinaTime = time.FixedZone("UTC+8", 8*3600)
+	KoreaTime = time.FixedZone("UTC+9", 9*3600)
+)
+
+//LunarDate, a date in the Chinese lunisolar calendar
+type LunarDate struct {
+	Year  int
+	Month int
+	Day   int
+	Leap  bool
+}
+
+//lunarMonth is one month of a lunar year, start is a dateKey
+type lunarMonth struct {
+	year  int
+	month int
+	leap  bool
+	start time.Time
+}
+
+//newMoonCorrections are the new moon periodic terms of Meeus chapter 49,
+//a coefficient, the power of E, and multiples of M, M', F and Omega
+var newMoonCorrections = [...][6]float64{
+	{-0.40720, 0, 0, 1, 0, 0}, {0.17241, 1, 1, 0, 0, 0}, {0.01608, 0, 0, 2, 0, 0},
+	{0.01039, 0, 0, 0, 2, 0}, {0.00739, 1, -1, 1, 0, 0}, {-0.00514, 1, 1, 1, 0, 0},
+	{0.00208, 2, 2, 0, 0, 0}, {-0.00111, 0, 0, 1, -2, 0}, {-0.00057, 0, 0, 1, 2, 0},
+	{0.00056, 1, 1, 2, 0, 0}, {-0.00042, 0, 0, 3, 0, 0}, {0.00042, 1, 1, 0, 2, 0},
+	{0.00038, 1, 1, 0, -2, 0}, {-0.00024, 1, -1, 2, 0, 0}, {-0.00017, 0, 0, 0, 0, 1},
+	{-0.00007, 0, 2, 1, 0, 0}, {0.00004, 0, 0, 2, -2, 0}, {0.00004, 0, 3, 0, 0, 0},
+	{0.00003, 0, 1, 1, -2, 0}, {0.00003, 0, 0, 2, 2, 0}, {-0.00003, 0, 1, 1, 2, 0},
+	{0.00003, 0, -1, 1, 2, 0}, {-0.00002, 0, -1, 1, -2, 0}, {-0.00002, 0, 1, 3, 0, 0},
+	{0.00002, 0, 0, 4, 0, 0},
+}
+
+//newMoonPlanetary are the additional corrections of Meeus chapter 49,
+//a coefficient and the argument's constant and rate in k
+var newMoonPlanetary = [...][3]float64{
+	{0.000325, 299.77, 0.107408}, {0.000165, 251.88, 0.016321}, {0.000164, 251.83, 26.651886},
+	{0.000126, 349.42, 36.412478}, {0.000110, 84.66, 18.206239}, {0.000062, 141.74, 53.303771},
+	{0.000060, 207.14, 2.453732}, {0.000056, 154.84, 7.306860}, {0.000047, 34.52, 27.261239},
+	{0.000042, 207.19, 0.121824}, {0.000040, 291.34, 1.844379}, {0.000037, 161.72, 24.198154},
+	{0.000035, 239.56, 25.513099}, {0.000023, 331.55, 3.592518},
+}
+
+//newMoon returns the instant of new moon number k in UTC,
+//k = 0 is the new moon of January 6th 2000
+func newMoon(k int) time.Time {
+	rad := math.Pi / 180
+	kf := float64(k)
+	t := kf / 1236.85
+
+	jde := 2451550.09766 + 29.530588861*kf + 0.00015437*t*t -
+		0.000000150*t*t*t + 0.00000000073*t*t*t*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := (2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t) * rad
+	mp := (201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t -
+		0.000000058*t*t*t*t) * rad
+	f := (160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t +
+		0.000000011*t*t*t*t) * rad
+	om := (124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t) * rad
+
+	for _, c := range newMoonCorrections {
+		jde += c[0] * math.Pow(e, c[1]) * math.Sin(c[2]*m+c[3]*mp+c[4]*f+c[5]*om)
+	}
+	jde += 0.000325 * math.Sin((299.77+0.107408*kf-0.009173*t*t)*rad)
+	for _, c := range newMoonPlanetary[1:] {
+		jde += c[0] * math.Sin((c[1]+c[2]*kf)*rad)
+	}
+
+	return julianDayTime(jde).Add(-time.Duration(deltaT(julianDayTime(jde).Year()) * float64(time.Second)))
+}
+
+//newMoonBefore returns the number of the last new moon at or before an instant
+func newMoonBefore(t time.Time) int {
+	jd := float64(t.Unix())/86400 + 2440587.5
+	k := int(math.Floor((jd - 2451550.09766) / 29.530588861))
+	for i := 0; i < maxNewMoonSteps && !newMoon(k+1).After(t); i++ {
+		k++
+	}
+	for i := 0; i < maxNewMoonSteps && newMoon(k).After(t); i++ {
+		k--
+	}
+
+	return k
+}
+
+//sunLongitude returns the apparent longitude of the sun in degrees
+//at an instant, after Meeus chapter 25
+func sunLongitude(t time.Time) float64 {
+	rad := math.Pi / 180
+	jde := float64(t.Unix())/86400 + 2440587.5 + deltaT(t.Year())/86400
+	c := (jde - 2451545.0) / 36525
+
+	l0 := 280.46646 + 36000.76983*c + 0.0003032*c*c
+	m := (357.52911 + 35999.05029*c - 0.0001537*c*c) * rad
+	eq := (1.914602-0.004817*c-0.000014*c*c)*math.Sin(m) +
+		(0.019993-0.000101*c)*math.Sin(2*m) + 0.000289*math.Sin(3*m)
+	om := (125.04 - 1934.136*c) * rad
+
+	l := l0 + eq - 0.00569 - 0.00478*math.Sin(om)
+	return math.Mod(math.Mod(l, 360)+360, 360)
+}
+
+//SolarTerm returns the instant in a Gregorian year at which the apparent longitude
+//of the sun reaches a multiple of 15 degrees, 0 being the March equinox,
+//15 Qingming and 270 the December solstice
+func SolarTerm(year int, longitude float64) time.Time {
+	switch longitude {
+	case 0:
+		return MarchEquinox(year)
+	case 90:
+		return JuneSolstice(year)
+	case 180:
+		return SeptemberEquinox(year)
+	case 270:
+		return DecemberSolstice(year)
+	}
+
+	// start from the mean date and refine,
+	// the terms past the December solstice fall early in the year
+	mean := longitude
+	if mean > 270 {
+		mean -= 360
+	}
+	t := MarchEquinox(year).Add(time.Duration(mean / 360 * 365.2422 * 24 * float64(time.Hour)))
+	for i := 0; i < 10; i++ {
+		diff := math.Mod(longitude-sunLongitude(t)+540, 360) - 180
+		step := time.Duration(diff / 360 * 365.2422 * 24 * float64(time.Hour))
+		t = t.Add(step)
+		if step > -time.Second && step < time.Second {
+			break
+		}
+	}
+
+	return t
+}
+
+//month11Start returns the first day of the lunar month holding
+//the December solstice of a year
+func month11Start(year int, loc *time.Location) time.Time {
+	ws := dayIn(DecemberSolstice(year), loc)
+	k := newMoonBefore(DecemberSolstice(year))
+	for i := 0; i < maxNewMoonSteps && !dayIn(newMoon(k+1), loc).After(ws); i++ {
+		k++
+	}
+	for i := 0; i < maxNewMoonSteps && dayIn(newMoon(k), loc).After(ws); i++ {
+		k--
+	}
+
+	return dayIn(newMoon(k), loc)
+}
+
+//dayIn is the dateKey of the day an instant falls on in a location
+func dayIn(t time.Time, loc *time.Location) time.Time {
+	return dateKey(t.In(loc))
+}
+
+//suiKey identifies a cached sui
+type suiKey struct {
+	year int
+	loc  string
+}
+
+//suiCache holds computed suis, they never change
+var suiCache = struct {
+	sync.RWMutex
+	m map[suiKey][]lunarMonth
+}{m: map[suiKey][]lunarMonth{}}
+
+//lunarSui returns the lunar months from the 11th month of the year
+//before up to, and not including, the 11th month of a year
+func lunarSui(year int, loc *time.Location) []lunarMonth {
+	key := suiKey{year, loc.String()}
+
+	suiCache.RLock()
+	months, ok := suiCache.m[key]
+	suiCache.RUnlock()
+	if ok {
+		return months
+	}
+
+	months = computeSui(year, loc)
+
+	suiCache.Lock()
+	suiCache.m[key] = months
+	suiCache.Unlock()
+
+	return months
+}
+
+//computeSui computes the months of a sui, see lunarSui
+func computeSui(year int, loc *time.Location) []lunarMonth {
+	start := month11Start(year-1, loc)
+	end := month11Start(year, loc)
+
+	// month starts in the sui
+	k := newMoonBefore(start.Add(24 * time.Hour))
+	for i := 0; i < maxNewMoonSteps && dayIn(newMoon(k), loc).After(start); i++ {
+		k--
+	}
+	for i := 0; i < maxNewMoonSteps && dayIn(newMoon(k), loc).Before(start); i++ {
+		k++
+	}
+	// a sui has 12 or 13 months
+	var starts []time.Time
+	for ; len(starts) < 13 && dayIn(newMoon(k), loc).Before(end); k++ {
+		starts = append(starts, dayIn(newMoon(k), loc))
+	}
+	starts = append(starts, end)
+
+	// principal terms, the multiples of 30 degrees, in the sui
+	var terms []time.Time
+	for lon := 270.0; lon < 630; lon += 30 {
+		y := year
+		if lon == 270 {
+			y = year - 1
+		}
+		terms = append(terms, dayIn(SolarTerm(y, math.Mod(lon, 360)), loc))
+	}
+
+	leapYear := len(starts) == 14
+	leapFound := false
+	months := make([]lunarMonth, 0, len(starts)-1)
+	num := 10
+	for i := 0; i < len(starts)-1; i++ {
+		leap := false
+		if leapYear && !leapFound && !hasTerm(terms, starts[i], starts[i+1]) {
+			leap, leapFound = true, true
+		}
+		if !leap {
+			num = num%12 + 1
+		}
+		y := year
+		if num >= 11 {
+			y = year - 1
+		}
+		months = append(months, lunarMonth{year: y, month: num, leap: leap, start: starts[i]})
+	}
+
+	return months
+}
+
+//hasTerm checks for a principal solar term in a month from start to end
+func hasTerm(terms []time.Time, start, end time.Time) bool {
+	for _, t := range terms {
+		if !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//LunarToSolar converts a lunar date to the Gregorian day it falls on
+//in a time zone, the result is midnight UTC of that day,
+//ok is false for dates that do not exist or years outside LunarMinYear to LunarMaxYear
+func LunarToSolar(ld LunarDate, loc *time.Location) (time.Time, bool) {
+	if ld.Year < LunarMinYear || ld.Year > LunarMaxYear {
+		return time.Time{}, false
+	}
+
+	for _, y := range []int{ld.Year, ld.Year + 1} {
+		for _, lm := range lunarSui(y, loc) {
+			if lm.year == ld.Year && lm.month == ld.Month && lm.leap == ld.Leap {
+				return lm.start.AddDate(0, 0, ld.Day-1), true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+//SolarToLunar converts the day of t to the lunar calendar of a time zone,
+//the result is the zero LunarDate for years outside LunarMinYear to LunarMaxYear
+func SolarToLunar(t time.Time, loc *time.Location) LunarDate {
+	day := dateKey(t)
+	y := day.Year()
+	if y < LunarMinYear || y > LunarMaxYear {
+		return LunarDate{}
+	}
+	if !day.Before(month11Start(y, loc)) {
+		y++
+	}
+
+	months := lunarSui(y, loc)
+	for i := len(months) - 1; i >= 0; i-- {
+		if !day.Before(months[i].start) {
+			lm := months[i]
+			return LunarDate{
+				Year:  lm.year,
+				Month: lm.month,
+				Day:   int(day.Sub(lm.start).Hours()/24) + 1,
+				Leap:  lm.leap,
+			}
+		}
+	}
+
+	return LunarDate{}
+}
+
+//LunarNewYear returns the first day of the lunar year starting in a Gregorian year,
+//the zero time for years outside LunarMinYear to LunarMaxYear
+func LunarNewYear(year int, loc *time.Location) time.Time {
+	t, _ := LunarToSolar(LunarDate{Year: year, Month: 1, Day: 1}, loc)
+	return t
+}
@@ -0,0 +1,51 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLunarNewYear(t *testing.T) {
+	tests := []struct {
+		year int
+		loc  *time.Location
+		want time.Time
+	}{
+		{2020, ChinaTime, time.Date(2020, time.January, 25, 0, 0, 0, 0, time.UTC)},
+		{2024, ChinaTime, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)},
+		{2025, KoreaTime, time.Date(2025, time.January, 29, 0, 0, 0, 0, time.UTC)},
+		{2026, ChinaTime, time.Date(2026, time.February, 17, 0, 0, 0, 0, time.UTC)},
+		{LunarMaxYear + 1, ChinaTime, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		if got := LunarNewYear(tt.year, tt.loc); !got.Equal(tt.want) {
+			t.Errorf("LunarNewYear(%d, %v) = %v, want %v", tt.year, tt.loc, got, tt.want)
+		}
+	}
+}
+
+func TestLunarFarYearsReturn(t *testing.T) {
+	// these years used to overflow the julian day conversion and never return
+	for _, y := range []int{2263, 2270, 2300} {
+		if LunarNewYear(y, KoreaTime).Year() != y {
+			t.Errorf("LunarNewYear(%d) is not in %d", y, y)
+		}
+	}
+	SSECal{}.IsBusinessDay(time.Date(2270, time.June, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func TestCNYearRange(t *testing.T) {
+	if err := CheckYear(SSECal{}, 2018); err == nil {
+		t.Errorf("CheckYear(SSECal, 2018) = nil, want an error")
+	}
+	for _, day := range []time.Time{
+		time.Date(2021, time.February, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.February, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC),
+	} {
+		if (SSECal{}).IsBusinessDay(day) {
+			t.Errorf("SSE is open on %s, want closed", day.Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Mexican holidays
+//Constitution Day, Benito Juárez's Birthday and Revolution Day
+//are held on Mondays since 2006, the presidential inauguration
+//moved from December 1st to October 1st in 2024
+var (
+	MondayHolidaysMX  = Effective{From: 2006}
+	InaugurationOctMX = Effective{From: 2024}
+)
+
+//MXCal for Mexican banking holidays
+//has all BasicCal methods
+type MXCal struct {
+	BasicCal
+}
+
+//IsConstitutionDay checks for Constitution Day
+func (cal MXCal) IsConstitutionDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MondayHolidaysMX.Covers(y) {
+		// first Monday of February
+		return d <= 7 && w == time.Monday && m == time.February
+	}
+
+	return d == 5 && m == time.February
+}
+
+//IsBenitoJuarezDay checks for Benito Juárez's Birthday
+func (cal MXCal) IsBenitoJuarezDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MondayHolidaysMX.Covers(y) {
+		// third Monday of March
+		return (d >= 15 && d <= 21) && w == time.Monday && m == time.March
+	}
+
+	return d == 21 && m == time.March
+}
+
+//IsHolyThursday checks for Holy Thursday
+func (cal MXCal) IsHolyThursday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-4
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal MXCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsRevolutionDay checks for Revolution Day
+func (cal MXCal) IsRevolutionDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MondayHolidaysMX.Covers(y) {
+		// third Monday of November
+		return (d >= 15 && d <= 21) && w == time.Monday && m == time.November
+	}
+
+	return d == 20 && m == time.November
+}
+
+//IsInaugurationDay checks for the inauguration of the president, every six years
+func (cal MXCal) IsInaugurationDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if y%6 != 2 {
+		return false
+	}
+	if InaugurationOctMX.Covers(y) {
+		return d == 1 && m == time.October
+	}
+
+	return d == 1 && m == time.December
+}
+
+//HolidayName names Mexican banking holidays
+func (cal MXCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case d == 1 && m == time.January:
+		return "New Year's Day", true
+	case cal.IsConstitutionDay(y, m, d, w):
+		return "Constitution Day", true
+	case cal.IsBenitoJuarezDay(y, m, d, w):
+		return "Benito Juárez's Birthday", true
+	case cal.IsHolyThursday(y, dd):
+		return "Holy Thursday", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case d == 1 && m == time.May:
+		return "Labour Day", true
+	case d == 16 && m == time.September:
+		return "Independence Day", true
+	case cal.IsInaugurationDay(y, m, d, w):
+		return "Inauguration Day", true
+	case d == 2 && m == time.November:
+		return "Day of the Dead", true
+	case cal.IsRevolutionDay(y, m, d, w):
+		return "Revolution Day", true
+	case d == 12 && m == time.December:
+		return "Our Lady of Guadalupe", true
+	case d == 25 && m == time.December:
+		return "Christmas Day", true
+	}
+
+	return "", false
+}
+
+//BMVCal, calendar for the Mexican Stock Exchange
+//has all MXCal methods
+//It also satisfies BizCal interface
+type BMVCal struct {
+	MXCal
+}
+
+//IsBusinessDay checks for business day according to BMV Calendar
+func (cal BMVCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
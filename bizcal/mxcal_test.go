@@ -0,0 +1,36 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMXHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Monday holidays since 2006
+		{time.Date(2024, time.February, 5, 0, 0, 0, 0, time.UTC), "Constitution Day"},
+		{time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC), "Benito Juárez's Birthday"},
+		{time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.November, 18, 0, 0, 0, 0, time.UTC), "Revolution Day"},
+		{time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2005, time.March, 21, 0, 0, 0, 0, time.UTC), "Benito Juárez's Birthday"},
+		{time.Date(2005, time.November, 21, 0, 0, 0, 0, time.UTC), ""},
+		// Easter
+		{time.Date(2024, time.March, 28, 0, 0, 0, 0, time.UTC), "Holy Thursday"},
+		{time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC), "Good Friday"},
+		// the inauguration, October 1st since 2024
+		{time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC), "Inauguration Day"},
+		{time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC), "Inauguration Day"},
+		{time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (MXCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("MX %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of New Zealand holidays
+var (
+	MondayisationNZ = Effective{From: 2014}
+	KingsBirthdayNZ = Effective{From: 2023}
+)
+
+//nzMatariki lists the Matariki public holidays fixed by the
+//Te Kāhui o Matariki Public Holiday Act 2022, as month and day by year
+//The Act fixes the dates to 2052, later years are outside the YearRange of NZCal
+var nzMatariki = map[int][2]int{
+	2022: {6, 24}, 2023: {7, 14}, 2024: {6, 28}, 2025: {6, 20}, 2026: {7, 10},
+	2027: {6, 25}, 2028: {7, 14}, 2029: {7, 6}, 2030: {6, 21}, 2031: {7, 11},
+	2032: {7, 2}, 2033: {6, 24}, 2034: {7, 7}, 2035: {6, 29}, 2036: {7, 18},
+	2037: {7, 10}, 2038: {6, 25}, 2039: {7, 15}, 2040: {7, 6}, 2041: {7, 19},
+	2042: {7, 11}, 2043: {7, 3}, 2044: {6, 24}, 2045: {7, 7}, 2046: {6, 29},
+	2047: {7, 19}, 2048: {7, 3}, 2049: {6, 25}, 2050: {7, 15}, 2051: {6, 30},
+	2052: {6, 21},
+}
+
+//nzOneOffs lists the one-off public holidays
+var nzOneOffs = map[time.Time]string{
+	time.Date(2022, time.September, 26, 0, 0, 0, 0, time.UTC): "Queen Elizabeth II Memorial Day",
+}
+
+//NZCal for New Zealand public holidays
+//has all BasicCal methods
+type NZCal struct {
+	BasicCal
+}
+
+//IsNewYearsDay checks for New Year's Day
+func (cal NZCal) IsNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Mondayised like Christmas, to Monday or Tuesday
+	return m == time.January &&
+		(d == 1 || (d == 3 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsDayAfterNewYearsDay checks for the Day after New Year's Day
+func (cal NZCal) IsDayAfterNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Mondayised like Boxing Day, to Monday or Tuesday
+	return m == time.January &&
+		(d == 2 || (d == 4 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsWaitangiDay checks for Waitangi Day, Mondayised since 2014
+func (cal NZCal) IsWaitangiDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MondayisationNZ.Covers(y) {
+		return (d == 6 || ((d == 7 || d == 8) && w == time.Monday)) && m == time.February
+	}
+
+	return d == 6 && m == time.February
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal NZCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsEasterMonday checks for Easter Monday
+func (cal NZCal) IsEasterMonday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)
+}
+
+//IsAnzacDay checks for Anzac Day, Mondayised since 2014
+func (cal NZCal) IsAnzacDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if MondayisationNZ.Covers(y) {
+		return (d == 25 || ((d == 26 || d == 27) && w == time.Monday)) && m == time.April
+	}
+
+	return d == 25 && m == time.April
+}
+
+//IsKingsBirthday checks for the King's, before 2023 the Queen's, Birthday
+func (cal NZCal) IsKingsBirthday(y int, m time.Month, d int, w time.Weekday) bool {
+	// first Monday of June
+	return d <= 7 && w == time.Monday && m == time.June
+}
+
+//IsMatariki checks for the Matariki public holiday, observed since 2022
+func (cal NZCal) IsMatariki(y int, m time.Month, d int, w time.Weekday) bool {
+	md, ok := nzMatariki[y]
+	return ok && m == time.Month(md[0]) && d == md[1]
+}
+
+//IsLabourDay checks for Labour Day
+func (cal NZCal) IsLabourDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// fourth Monday of October
+	return (d >= 22 && d <= 28) && w == time.Monday && m == time.October
+}
+
+//IsChristmas checks for Christmas
+func (cal NZCal) IsChristmas(y int, m time.Month, d int, w time.Weekday) bool {
+	// Christmas (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 25 || (d == 27 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsBoxingDay checks for Boxing Day
+func (cal NZCal) IsBoxingDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Boxing Day (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 26 || (d == 28 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//YearRange returns the years up to the last Matariki date fixed by law
+func (cal NZCal) YearRange() (from, to int) {
+	from, to = MinYear, MinYear
+	for y := range nzMatariki {
+		if y > to {
+			to = y
+		}
+	}
+
+	return from, to
+}
+
+//HolidayName names New Zealand public holidays, without the regional anniversary days
+func (cal NZCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsDayAfterNewYearsDay(y, m, d, w):
+		return "Day after New Year's Day", true
+	case cal.IsWaitangiDay(y, m, d, w):
+		return "Waitangi Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsAnzacDay(y, m, d, w):
+		return "Anzac Day", true
+	case cal.IsKingsBirthday(y, m, d, w) && KingsBirthdayNZ.Covers(y):
+		return "King's Birthday", true
+	case cal.IsKingsBirthday(y, m, d, w):
+		return "Queen's Birthday", true
+	case cal.IsMatariki(y, m, d, w):
+		return "Matariki", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsBoxingDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	if name, ok := nzOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//NZXCal, calendar for the New Zealand Exchange
+//has all NZCal methods
+//It also satisfies BizCal interface
+type NZXCal struct {
+	NZCal
+}
+
+//IsBusinessDay checks for business day according to NZX Calendar
+func (cal NZXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
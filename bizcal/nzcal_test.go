@@ -0,0 +1,50 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNZMondayisation(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Waitangi Day and Anzac Day on a Saturday move to Monday since 2014
+		{time.Date(2016, time.February, 8, 0, 0, 0, 0, time.UTC), "Waitangi Day"},
+		{time.Date(2015, time.April, 27, 0, 0, 0, 0, time.UTC), "Anzac Day"},
+		{time.Date(2021, time.April, 26, 0, 0, 0, 0, time.UTC), "Anzac Day"},
+		// but not before
+		{time.Date(2010, time.February, 8, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2009, time.April, 27, 0, 0, 0, 0, time.UTC), ""},
+		// New Year's Day on a Saturday, the day after on a Sunday
+		{time.Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{time.Date(2022, time.January, 4, 0, 0, 0, 0, time.UTC), "Day after New Year's Day"},
+	}
+	for _, tt := range tests {
+		if name, _ := (NZXCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("NZXCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestNZMatariki(t *testing.T) {
+	for _, day := range []time.Time{
+		time.Date(2022, time.June, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.June, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2052, time.June, 21, 0, 0, 0, 0, time.UTC),
+	} {
+		if name, _ := (NZXCal{}).HolidayName(day); name != "Matariki" {
+			t.Errorf("NZXCal %s is %q, want Matariki", day.Format("2006-01-02"), name)
+		}
+	}
+	if !(NZXCal{}).IsBusinessDay(time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NZXCal 2021-07-02 closed, want open before Matariki was a holiday")
+	}
+	if from, to := YearRange(NZXCal{}); from != MinYear || to != 2052 {
+		t.Errorf("YearRange(NZXCal) = %d to %d, want %d to 2052", from, to, MinYear)
+	}
+	if err := CheckYear(NZXCal{}, 2053); err == nil {
+		t.Errorf("CheckYear(NZXCal, 2053) succeeded, want an error")
+	}
+}
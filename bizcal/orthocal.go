@@ -0,0 +1,255 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of Romanian holidays
+var (
+	StAndrewsDayRO = Effective{From: 2012}
+	UnionDayRO     = Effective{From: 2017}
+	ChildrensDayRO = Effective{From: 2017}
+	GoodFridayRO   = Effective{From: 2018}
+	EpiphanyDaysRO = Effective{From: 2024}
+)
+
+//IsCleanMonday checks for Clean Monday, the first day of Orthodox Lent
+func (cal EUCal) IsCleanMonday(y int, dd int) bool {
+	return dd == cal.OrthodoxEasterMonday(y)-49
+}
+
+//IsOrthodoxGoodFriday checks for Orthodox Good Friday
+func (cal EUCal) IsOrthodoxGoodFriday(y int, dd int) bool {
+	return dd == cal.OrthodoxEasterMonday(y)-3
+}
+
+//IsOrthodoxEasterMonday checks for Orthodox Easter Monday
+func (cal EUCal) IsOrthodoxEasterMonday(y int, dd int) bool {
+	return dd == cal.OrthodoxEasterMonday(y)
+}
+
+//IsOrthodoxWhitMonday checks for Orthodox Whit Monday, the Monday of the Holy Spirit
+func (cal EUCal) IsOrthodoxWhitMonday(y int, dd int) bool {
+	return dd == cal.OrthodoxEasterMonday(y)+49
+}
+
+//AthensCal, calendar for the Athens Stock Exchange
+//has all EUCal methods
+//It also satisfies BizCal interface
+type AthensCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Athens Stock Exchange Calendar
+func (cal AthensCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Athens Stock Exchange holidays
+func (cal AthensCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsEpiphany(y, m, d, w):
+		return "Epiphany", true
+	case cal.IsCleanMonday(y, dd):
+		return "Clean Monday", true
+	case d == 25 && m == time.March:
+		return "Independence Day", true
+	case cal.IsOrthodoxGoodFriday(y, dd):
+		return "Orthodox Good Friday", true
+	case cal.IsOrthodoxEasterMonday(y, dd):
+		return "Orthodox Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsOrthodoxWhitMonday(y, dd):
+		return "Orthodox Whit Monday", true
+	case cal.IsAssumption(y, m, d, w):
+		return "Assumption Day", true
+	case d == 28 && m == time.October:
+		return "Ochi Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Synaxis of the Mother of God", true
+	}
+
+	return "", false
+}
+
+//BucharestCal, calendar for the Bucharest Stock Exchange
+//has all EUCal methods
+//It also satisfies BizCal interface
+type BucharestCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Bucharest Stock Exchange Calendar
+func (cal BucharestCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Bucharest Stock Exchange holidays
+func (cal BucharestCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w) || (d == 2 && m == time.January):
+		return "New Year's Day", true
+	case EpiphanyDaysRO.Covers(y) && cal.IsEpiphany(y, m, d, w):
+		return "Epiphany", true
+	case EpiphanyDaysRO.Covers(y) && d == 7 && m == time.January:
+		return "Synaxis of St. John the Baptist", true
+	case UnionDayRO.Covers(y) && d == 24 && m == time.January:
+		return "Union Day", true
+	case GoodFridayRO.Covers(y) && cal.IsOrthodoxGoodFriday(y, dd):
+		return "Orthodox Good Friday", true
+	case cal.IsOrthodoxEasterMonday(y, dd):
+		return "Orthodox Easter Monday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case ChildrensDayRO.Covers(y) && d == 1 && m == time.June:
+		return "Children's Day", true
+	case cal.IsOrthodoxWhitMonday(y, dd):
+		return "Orthodox Whit Monday", true
+	case cal.IsAssumption(y, m, d, w):
+		return "Assumption Day", true
+	case StAndrewsDayRO.Covers(y) && d == 30 && m == time.November:
+		return "St. Andrew's Day", true
+	case d == 1 && m == time.December:
+		return "National Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Second Day of Christmas", true
+	}
+
+	return "", false
+}
+
+//BelgradeCal, calendar for the Belgrade Stock Exchange
+//has all EUCal methods
+//It also satisfies BizCal interface
+type BelgradeCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Belgrade Stock Exchange Calendar
+func (cal BelgradeCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Belgrade Stock Exchange holidays
+//A state holiday falling on a Sunday moves to the next working day,
+//Orthodox Christmas and Easter do not move
+func (cal BelgradeCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	// the third day of a two day holiday, when one of the two is a Sunday
+	moved := w == time.Monday || w == time.Tuesday
+
+	switch {
+	case m == time.January && (d == 1 || d == 2 || (d == 3 && moved)):
+		return "New Year's Day", true
+	case d == 7 && m == time.January:
+		return "Orthodox Christmas", true
+	case m == time.February && (d == 15 || d == 16 || (d == 17 && moved)):
+		return "Statehood Day", true
+	case cal.IsOrthodoxGoodFriday(y, dd):
+		return "Orthodox Good Friday", true
+	case cal.IsOrthodoxEasterMonday(y, dd):
+		return "Orthodox Easter Monday", true
+	case m == time.May && (d == 1 || d == 2 || (d == 3 && moved)):
+		return "Labour Day", true
+	case m == time.November && (d == 11 || (d == 12 && w == time.Monday)):
+		return "Armistice Day", true
+	}
+
+	return "", false
+}
+
+//CyprusCal, calendar for the Cyprus Stock Exchange
+//has all EUCal methods
+//It also satisfies BizCal interface
+type CyprusCal struct {
+	EUCal
+}
+
+//IsBusinessDay checks for business day according to Cyprus Stock Exchange Calendar
+func (cal CyprusCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names Cyprus Stock Exchange holidays
+func (cal CyprusCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsEpiphany(y, m, d, w):
+		return "Epiphany", true
+	case cal.IsCleanMonday(y, dd):
+		return "Clean Monday", true
+	case d == 25 && m == time.March:
+		return "Greek Independence Day", true
+	case d == 1 && m == time.April:
+		return "Cyprus National Day", true
+	case cal.IsOrthodoxGoodFriday(y, dd):
+		return "Orthodox Good Friday", true
+	case cal.IsOrthodoxEasterMonday(y, dd):
+		return "Orthodox Easter Monday", true
+	case dd == cal.OrthodoxEasterMonday(y)+1:
+		return "Orthodox Easter Tuesday", true
+	case cal.IsLabourDay(y, m, d, w):
+		return "Labour Day", true
+	case cal.IsOrthodoxWhitMonday(y, dd):
+		return "Orthodox Whit Monday", true
+	case cal.IsAssumption(y, m, d, w):
+		return "Assumption Day", true
+	case d == 1 && m == time.October:
+		return "Cyprus Independence Day", true
+	case d == 28 && m == time.October:
+		return "Ochi Day", true
+	case cal.IsChristmasEve(y, m, d, w):
+		return "Christmas Eve", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsStStephensDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	return "", false
+}
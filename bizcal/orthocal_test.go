@@ -0,0 +1,46 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrthodoxEaster(t *testing.T) {
+	for _, easter := range []time.Time{
+		time.Date(2000, time.April, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2010, time.April, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.April, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.May, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.April, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.May, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.April, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2030, time.April, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2100, time.May, 2, 0, 0, 0, 0, time.UTC),
+	} {
+		if got, want := (BasicCal{}).OrthodoxEasterMonday(easter.Year()), easter.YearDay()+1; got != want {
+			t.Errorf("OrthodoxEasterMonday(%d) = %d, want %d, the day after %s", easter.Year(), got, want, easter.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestAthensHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// Orthodox Easter on May 5th 2024
+		{time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC), "Clean Monday"},
+		{time.Date(2024, time.May, 3, 0, 0, 0, 0, time.UTC), "Orthodox Good Friday"},
+		{time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC), "Orthodox Easter Monday"},
+		{time.Date(2024, time.June, 24, 0, 0, 0, 0, time.UTC), "Orthodox Whit Monday"},
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC), "Ochi Day"},
+	}
+
+	for _, tt := range tests {
+		if name, _ := (AthensCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("Athens %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package bizcal
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+//registry maps upper case calendar names and aliases to calendars
+var registry = struct {
+	sync.RWMutex
+	cals    map[string]BizCal
+	names   map[string]string
+	aliases map[string][]string
+	revs    map[string]int
+}{
+	cals:    map[string]BizCal{},
+	names:   map[string]string{},
+	aliases: map[string][]string{},
+	revs:    map[string]int{},
+}
+
+func init() {
+	Register("US-SETTLE", USSettleCal{})
+	Register("US-LIBOR", USLiborCal{})
+	Register("US-GOVBOND", USGovBondCal{})
+	Register("US-FED", USFedCal{})
+	Register("US-SIFMA", SIFMACal{}, "SIFMA")
+	Register("US-SOFR", SOFRCal{}, "SOFR")
+	Register("XNYS", NYSECal{}, "NYSE")
+	Register("CA-SETTLE", CASettleCal{})
+	Register("XTSE", TSXCal{}, "TSX")
+	Register("GB-SETTLE", UKSettleCal{}, "UK-SETTLE")
+	Register("GB-GILTS", UKGiltCal{})
+	Register("GB-SCT", UKScotlandCal{})
+	Register("GB-NIR", UKNorthernIrelandCal{})
+	Register("XLON", LSECal{}, "LSE")
+	Register("TARGET", TARGETCal{}, "EUR", "TARGET2")
+	Register("XETR", XetraCal{}, "XETRA", "XFRA")
+	Register("XPAR", EuronextCal{}, "EURONEXT", "XAMS", "XBRU", "XLIS")
+	Register("XSWX", SIXCal{}, "SIX")
+	Register("XMIL", BorsaItalianaCal{}, "BORSA-ITALIANA")
+	Register("XSTO", StockholmCal{})
+	Register("XOSL", OsloCal{})
+	Register("XCSE", CopenhagenCal{})
+	Register("XHEL", HelsinkiCal{})
+	Register("XATH", AthensCal{}, "ATHEX")
+	Register("XBSE", BucharestCal{}, "BVB")
+	Register("XBEL", BelgradeCal{})
+	Register("XCYS", CyprusCal{}, "CSE")
+	Register("JP-SETTLE", JPSettleCal{})
+	Register("XJPX", JPXCal{}, "JPX", "XTKS", "TSE")
+	Register("XSHG", SSECal{}, "SSE")
+	Register("XSHE", SZSECal{}, "SZSE")
+	Register("CFETS", CFETSCal{}, "CN-IB")
+	Register("XHKG", HKEXCal{}, "HKEX")
+	Register("XSES", SGXCal{}, "SGX")
+	Register("XSAU", TadawulCal{}, "TADAWUL")
+	Register("XDFM", DFMCal{}, "DFM")
+	Register("XADS", ADXCal{}, "ADX")
+	Register("XIST", BorsaIstanbulCal{}, "BIST")
+	Register("XIDX", IDXCal{}, "IDX")
+	Register("XTAE", TASECal{}, "TASE")
+	Register("XNSE", NSECal{}, "NSE")
+	Register("XBOM", BSECal{}, "BSE")
+	Register("XASX", ASXCal{}, "ASX")
+	Register("AU-SYD", SydneyCal{}, "AUSY")
+	Register("AU-MEL", MelbourneCal{}, "AUME")
+	Register("XNZE", NZXCal{}, "NZX")
+	Register("BVMF", B3Cal{}, "B3")
+	Register("BR-ANBIMA", ANBIMACal{}, "ANBIMA")
+	Register("XMEX", BMVCal{}, "BMV")
+	Register("XBUE", BYMACal{}, "BYMA")
+	Register("XSGO", SantiagoCal{}, "BCS")
+	Register("XBOG", BVCCal{}, "BVC")
+	Register("XKRX", KRXCal{}, "KRX")
+}
+
+//Register adds a calendar under a name and optional aliases
+//names are case insensitive, registering a name again replaces it
+func Register(name string, cal BizCal, aliases ...string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	key := strings.ToUpper(name)
+	registry.cals[key] = cal
+	registry.names[key] = key
+	for _, a := range aliases {
+		registry.names[strings.ToUpper(a)] = key
+	}
+	registry.aliases[key] = append(registry.aliases[key], aliases...)
+	registry.revs[key]++
+}
+
+//Lookup finds a registered calendar by name or alias
+func Lookup(name string) (BizCal, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	key, ok := registry.names[strings.ToUpper(name)]
+	if !ok {
+		return nil, false
+	}
+
+	return registry.cals[key], true
+}
+
+//CalendarNames returns the sorted names of all registered calendars
+//aliases are not included
+func CalendarNames() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.cals))
+	for name := range registry.cals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//CalendarAliases returns the aliases registered for a calendar name
+func CalendarAliases(name string) []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return append([]string(nil), registry.aliases[strings.ToUpper(name)]...)
+}
+
+//CalendarRevision returns how many times a calendar name has been registered,
+//it changes whenever Register replaces the calendar
+func CalendarRevision(name string) int {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	key, ok := registry.names[strings.ToUpper(name)]
+	if !ok {
+		return 0
+	}
+
+	return registry.revs[key]
+}
+
+//CalendarName resolves a name or alias to the registered calendar name
+func CalendarName(name string) (string, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	key, ok := registry.names[strings.ToUpper(name)]
+	return key, ok
+}
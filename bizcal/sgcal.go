@@ -0,0 +1,142 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//sgFestivals lists the gazetted dates of the festivals Singapore announces
+//each year as month and day, in the order Hari Raya Puasa, Vesak Day,
+//Hari Raya Haji and Deepavali
+//Other years fall back to the tabular Islamic calendar for Hari Raya,
+//the 15th of the 4th lunar month for Vesak Day, and have no Deepavali,
+//so they are outside the YearRange of SGCal
+var sgFestivals = map[int][4][2]int{
+	2015: {{7, 17}, {6, 1}, {9, 24}, {11, 10}},
+	2016: {{7, 6}, {5, 21}, {9, 12}, {10, 29}},
+	2017: {{6, 25}, {5, 10}, {9, 1}, {10, 18}},
+	2018: {{6, 15}, {5, 29}, {8, 22}, {11, 6}},
+	2019: {{6, 5}, {5, 19}, {8, 11}, {10, 27}},
+	2020: {{5, 24}, {5, 7}, {7, 31}, {11, 14}},
+	2021: {{5, 13}, {5, 26}, {7, 20}, {11, 4}},
+	2022: {{5, 3}, {5, 15}, {7, 10}, {10, 24}},
+	2023: {{4, 22}, {6, 2}, {6, 29}, {11, 12}},
+	2024: {{4, 10}, {5, 22}, {6, 17}, {10, 31}},
+	2025: {{3, 31}, {5, 12}, {6, 7}, {10, 20}},
+	2026: {{3, 21}, {5, 31}, {5, 27}, {11, 8}},
+}
+
+//sgHijri is the tabular Islamic calendar, for the years past the gazetted dates
+var sgHijri = HijriCalendar{Method: TabularHijri}
+
+//sgOneOffs lists the one-off public holidays, polling days and jubilees
+var sgOneOffs = map[time.Time]string{
+	time.Date(2015, time.August, 7, 0, 0, 0, 0, time.UTC):     "SG50 Public Holiday",
+	time.Date(2015, time.September, 11, 0, 0, 0, 0, time.UTC): "Polling Day",
+	time.Date(2020, time.July, 10, 0, 0, 0, 0, time.UTC):      "Polling Day",
+	time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC):  "Polling Day",
+	time.Date(2025, time.May, 3, 0, 0, 0, 0, time.UTC):        "Polling Day",
+}
+
+//SGCal for Singapore public holidays, lunar dates are reckoned in China time
+//Holidays falling on a Sunday move to the next day that is not a holiday
+//has all BasicCal methods
+type SGCal struct {
+	BasicCal
+}
+
+//holidays lists the public holidays of a year with their observed days
+func (cal SGCal) holidays(y int) map[time.Time]string {
+	jan1 := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ny := LunarNewYear(y, ChinaTime)
+
+	hs := map[time.Time]string{
+		jan1:                "New Year's Day",
+		ny:                  "Chinese New Year",
+		ny.AddDate(0, 0, 1): "Chinese New Year",
+		jan1.AddDate(0, 0, cal.EasterMonday(y)-4):             "Good Friday",
+		time.Date(y, time.May, 1, 0, 0, 0, 0, time.UTC):       "Labour Day",
+		time.Date(y, time.August, 9, 0, 0, 0, 0, time.UTC):    "National Day",
+		time.Date(y, time.December, 25, 0, 0, 0, 0, time.UTC): "Christmas Day",
+	}
+
+	if f, ok := sgFestivals[y]; ok {
+		names := [...]string{"Hari Raya Puasa", "Vesak Day", "Hari Raya Haji", "Deepavali"}
+		for i, md := range f {
+			hs[time.Date(y, time.Month(md[0]), md[1], 0, 0, 0, 0, time.UTC)] = names[i]
+		}
+	} else {
+		for _, t := range sgHijri.Days(y, Shawwal, 1) {
+			hs[t] = "Hari Raya Puasa"
+		}
+		for _, t := range sgHijri.Days(y, DhulHijjah, 10) {
+			hs[t] = "Hari Raya Haji"
+		}
+		vesak, _ := LunarToSolar(LunarDate{Year: y, Month: 4, Day: 15}, ChinaTime)
+		hs[vesak] = "Vesak Day"
+	}
+
+	observeSundays(hs)
+
+	for day, name := range sgOneOffs {
+		if day.Year() == y {
+			hs[day] = name
+		}
+	}
+
+	return hs
+}
+
+//YearRange returns the years with gazetted festival dates, outside them
+//the fallback misses Deepavali and can be a day off for Hari Raya
+func (cal SGCal) YearRange() (from, to int) {
+	from, to = MaxYear, MinYear
+	for y := range sgFestivals {
+		if y < from {
+			from = y
+		}
+		if y > to {
+			to = y
+		}
+	}
+
+	return from, to
+}
+
+//HolidayName names Singapore public holidays
+func (cal SGCal) HolidayName(t time.Time) (string, bool) {
+	name, ok := cal.holidays(t.Year())[dateKey(t)]
+	return name, ok
+}
+
+//SGXCal, calendar for the Singapore Exchange
+//has all SGCal methods
+//It also satisfies BizCal interface
+type SGXCal struct {
+	SGCal
+}
+
+//IsBusinessDay checks for business day according to SGX Calendar
+func (cal SGXCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//EarlyClose returns the noon close of the half days on the eves of
+//Christmas, New Year and Chinese New Year
+func (cal SGXCal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	next := dateKey(t).AddDate(0, 0, 1)
+	if (m == time.December && (d == 24 || d == 31)) || next.Equal(LunarNewYear(next.Year(), ChinaTime)) {
+		return closeAt(t, 12, 0, Singapore), true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,14 @@
+package bizcal
+
+import (
+	"testing"
+)
+
+func TestSGYearRange(t *testing.T) {
+	if from, to := YearRange(SGXCal{}); from != 2015 || to != 2026 {
+		t.Errorf("YearRange(SGXCal) = %d to %d, want 2015 to 2026", from, to)
+	}
+	if err := CheckYear(SGXCal{}, 2014); err == nil {
+		t.Errorf("CheckYear(SGXCal, 2014) succeeded, want an error")
+	}
+}
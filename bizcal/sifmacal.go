@@ -0,0 +1,160 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//SIFMACal, calendar for the US bond market as recommended by SIFMA
+//has all USCal methods
+//It also satisfies BizCal interface
+type SIFMACal struct {
+	USCal
+}
+
+//bondGoodFridayOpen lists the years SIFMA recommended an early close
+//instead of a full close on Good Friday, when payrolls were published
+//Both SIFMACal and USGovBondCal stay open then
+var bondGoodFridayOpen = map[int]bool{2015: true, 2021: true, 2023: true}
+
+//isHoliday checks for the scheduled SIFMA holidays, leaving out special closings
+func (cal SIFMACal) isHoliday(t time.Time) bool {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	return cal.IsNewYearsDay(y, m, d, w) ||
+		cal.IsMLKDay(y, m, d, w) ||
+		cal.IsPresidentsDay(y, m, d, w) ||
+		(!bondGoodFridayOpen[y] && cal.IsGoodFriday(y, dd)) ||
+		cal.IsMemorialDay(y, m, d, w) ||
+		(JuneteenthGovBond.Covers(y) && cal.IsJuneteenth(y, m, d, w)) ||
+		cal.IsIndependenceDay(y, m, d, w) ||
+		cal.IsLaborDay(y, m, d, w) ||
+		cal.IsColumbusDay(y, m, d, w) ||
+		cal.IsVeteransDayNoSaturday(y, m, d, w) ||
+		cal.IsThanksgiving(y, m, d, w) ||
+		cal.IsChristmas(y, m, d, w)
+}
+
+//IsBusinessDay checks for business day according to SIFMA recommendations
+func (cal SIFMACal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) || cal.isHoliday(t) {
+		return false
+	}
+
+	y, m, d := t.Date()
+
+	// Special closings
+	if // President Bush's Funeral
+	(y == 2018 && m == time.December && d == 5) ||
+		// Hurricane Sandy
+		(y == 2012 && m == time.October && d == 30) ||
+		// President Ford's funeral
+		(y == 2007 && m == time.January && d == 2) ||
+		// President Reagan's funeral
+		(y == 2004 && m == time.June && d == 11) ||
+		// September 11-12, 2001
+		(y == 2001 && m == time.September && (d == 11 || d == 12)) {
+		return false
+	}
+
+	return true
+}
+
+//HolidayName names SIFMA holidays and special closings
+func (cal SIFMACal) HolidayName(t time.Time) (string, bool) {
+	if cal.IsBusinessDay(t) {
+		return "", false
+	}
+
+	if name, ok := cal.USCal.HolidayName(t); ok {
+		return name, true
+	}
+
+	if cal.IsWeekend(t) {
+		return "", false
+	}
+
+	return "Special closing", true
+}
+
+//sifmaEarlyCloses lists the ad hoc early close recommendations
+//as hour and minute in New York
+var sifmaEarlyCloses = map[time.Time][2]int{
+	// Hurricane Sandy
+	time.Date(2012, time.October, 29, 0, 0, 0, 0, time.UTC): {12, 0},
+	// Good Friday with payrolls
+	time.Date(2015, time.April, 3, 0, 0, 0, 0, time.UTC): {12, 0},
+	time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC): {12, 0},
+	time.Date(2023, time.April, 7, 0, 0, 0, 0, time.UTC): {12, 0},
+	// National Day of Mourning for President Carter
+	time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC): {14, 0},
+}
+
+//EarlyClose returns the recommended close time in New York when the
+//bond market closes early on a particular day.
+//SIFMA recommends a 2:00 p.m. close on the business day before each
+//scheduled holiday and on the day after Thanksgiving.
+//Early closes before special closings are listed in sifmaEarlyCloses.
+func (cal SIFMACal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	if hm, ok := sifmaEarlyCloses[dateKey(t)]; ok {
+		return closeAt(t, hm[0], hm[1], NewYork), true
+	}
+
+	// the next weekday is a scheduled holiday
+	next := t.AddDate(0, 0, 1)
+	for cal.IsWeekend(next) {
+		next = next.AddDate(0, 0, 1)
+	}
+	if cal.isHoliday(next) {
+		return closeAt(t, 14, 0, NewYork), true
+	}
+
+	// the day after Thanksgiving
+	prev := t.AddDate(0, 0, -1)
+	py, pm, pd := prev.Date()
+	if cal.IsThanksgiving(py, pm, pd, prev.Weekday()) {
+		return closeAt(t, 14, 0, NewYork), true
+	}
+
+	return time.Time{}, false
+}
+
+//sofrNotPublished lists the SIFMA business days the Federal Reserve Bank
+//of New York announced it would not publish SOFR
+//On the early close Good Friday of 2021 SOFR was published as usual,
+//in 2023 the Bank announced beforehand that it would not publish
+var sofrNotPublished = map[time.Time]string{
+	time.Date(2023, time.April, 7, 0, 0, 0, 0, time.UTC): "Good Friday",
+}
+
+//SOFRCal, calendar for the publication of the Secured Overnight
+//Financing Rate by the Federal Reserve Bank of New York.
+//SOFR is published for every US Government securities business day,
+//so it follows SIFMACal, except on the days in sofrNotPublished.
+//It also satisfies BizCal interface
+type SOFRCal struct {
+	SIFMACal
+}
+
+//IsBusinessDay checks for a SOFR publication day
+func (cal SOFRCal) IsBusinessDay(t time.Time) bool {
+	if _, ok := sofrNotPublished[dateKey(t)]; ok {
+		return false
+	}
+
+	return cal.SIFMACal.IsBusinessDay(t)
+}
+
+//HolidayName names SIFMA holidays and the days SOFR is not published
+func (cal SOFRCal) HolidayName(t time.Time) (string, bool) {
+	if name, ok := sofrNotPublished[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return cal.SIFMACal.HolidayName(t)
+}
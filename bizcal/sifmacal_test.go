@@ -0,0 +1,49 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSIFMAEarlyClose(t *testing.T) {
+	// 2:00 p.m. before scheduled holidays, nothing before unplanned closings
+	tests := []struct {
+		day   time.Time
+		early bool
+	}{
+		{time.Date(2001, time.September, 10, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2004, time.June, 10, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2018, time.December, 4, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, time.July, 3, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, time.November, 29, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		if _, ok := (SIFMACal{}).EarlyClose(tt.day); ok != tt.early {
+			t.Errorf("SIFMACal.EarlyClose(%s) = %v, want %v", tt.day.Format("2006-01-02"), ok, tt.early)
+		}
+	}
+}
+
+func TestBondGoodFriday(t *testing.T) {
+	// SIFMA and government bonds share the Good Fridays that were early closes
+	for _, y := range []int{2014, 2015, 2021, 2022, 2023, 2024} {
+		gf := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, (USCal{}).EasterMonday(y)-4)
+		want := bondGoodFridayOpen[y]
+		if got := (SIFMACal{}).IsBusinessDay(gf); got != want {
+			t.Errorf("SIFMACal.IsBusinessDay(%s) = %v, want %v", gf.Format("2006-01-02"), got, want)
+		}
+		if got := (USGovBondCal{}).IsBusinessDay(gf); got != want {
+			t.Errorf("USGovBondCal.IsBusinessDay(%s) = %v, want %v", gf.Format("2006-01-02"), got, want)
+		}
+	}
+
+	if (SOFRCal{}).IsBusinessDay(time.Date(2023, time.April, 7, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SOFR was published on 2023-04-07, want not published")
+	}
+	if !(SOFRCal{}).IsBusinessDay(time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SOFR was not published on 2021-04-02, want published")
+	}
+}
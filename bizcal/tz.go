@@ -0,0 +1,60 @@
+package bizcal
+
+import (
+	"time"
+
+	// embedded zone data so close times work without a system zoneinfo
+	_ "time/tzdata"
+)
+
+//Exchange time zones used for early close times and astronomical holidays
+var (
+	NewYork   = mustLoadLocation("America/New_York")
+	Toronto   = mustLoadLocation("America/Toronto")
+	London    = mustLoadLocation("Europe/London")
+	Paris     = mustLoadLocation("Europe/Paris")
+	Frankfurt = mustLoadLocation("Europe/Berlin")
+	Stockholm = mustLoadLocation("Europe/Stockholm")
+	Tokyo     = mustLoadLocation("Asia/Tokyo")
+	HongKong  = mustLoadLocation("Asia/Hong_Kong")
+	Singapore = mustLoadLocation("Asia/Singapore")
+	Istanbul  = mustLoadLocation("Europe/Istanbul")
+	Kolkata   = mustLoadLocation("Asia/Kolkata")
+	Sydney    = mustLoadLocation("Australia/Sydney")
+	Santiago  = mustLoadLocation("America/Santiago")
+)
+
+//EarlyCloser is implemented by calendars that know when a business day
+//ends early, it returns the close time and whether the day closes early
+type EarlyCloser interface {
+	EarlyClose(t time.Time) (time.Time, bool)
+}
+
+//SpecialSession is a trading session held outside the regular hours,
+//often on a day that is otherwise a holiday
+type SpecialSession struct {
+	Name  string
+	Open  time.Time
+	Close time.Time
+}
+
+//SpecialSessioner is implemented by calendars with special sessions,
+//it returns the session held on the day of t
+type SpecialSessioner interface {
+	SpecialSession(t time.Time) (SpecialSession, bool)
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic("bizcal: " + err.Error())
+	}
+
+	return loc
+}
+
+//closeAt is the wall clock time hour:minute on the date of t in a location
+func closeAt(t time.Time, hour, minute int, loc *time.Location) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, hour, minute, 0, 0, loc)
+}
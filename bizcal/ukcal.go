@@ -0,0 +1,323 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Effective years of UK bank holidays
+var (
+	EarlyMayBankHoliday     = Effective{From: 1978}
+	StAndrewsDayBankHoliday = Effective{From: 2007}
+)
+
+//ukOneOffs lists the one-off bank holidays proclaimed across the UK
+var ukOneOffs = map[time.Time]string{
+	time.Date(1973, time.November, 14, 0, 0, 0, 0, time.UTC):  "Wedding of Princess Anne and Mark Phillips",
+	time.Date(1977, time.June, 7, 0, 0, 0, 0, time.UTC):       "Silver Jubilee of Elizabeth II",
+	time.Date(1981, time.July, 29, 0, 0, 0, 0, time.UTC):      "Wedding of Prince Charles and Lady Diana Spencer",
+	time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC):  "Millennium Celebrations",
+	time.Date(2002, time.June, 3, 0, 0, 0, 0, time.UTC):       "Golden Jubilee of Elizabeth II",
+	time.Date(2011, time.April, 29, 0, 0, 0, 0, time.UTC):     "Wedding of Prince William and Catherine Middleton",
+	time.Date(2012, time.June, 5, 0, 0, 0, 0, time.UTC):       "Diamond Jubilee of Elizabeth II",
+	time.Date(2022, time.June, 3, 0, 0, 0, 0, time.UTC):       "Platinum Jubilee of Elizabeth II",
+	time.Date(2022, time.September, 19, 0, 0, 0, 0, time.UTC): "State Funeral of Queen Elizabeth II",
+	time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC):        "Coronation of King Charles III",
+}
+
+//UKCal for all UK holidays
+//has all BasicCal methods
+type UKCal struct {
+	BasicCal
+}
+
+//IsNewYearsDay checks for New Year's Day
+func (cal UKCal) IsNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// January 1st, possibly moved to Monday
+	return (d == 1 || ((d == 2 || d == 3) && w == time.Monday)) && m == time.January
+}
+
+//IsSecondJanuary checks for the Scottish 2nd January holiday,
+//moved past the weekend and past New Year's Day's substitute
+func (cal UKCal) IsSecondJanuary(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.January && ((d == 2 && w != time.Saturday && w != time.Sunday) ||
+		((d == 3 || d == 4) && w == time.Tuesday) ||
+		(d == 4 && w == time.Monday))
+}
+
+//IsStPatricksDay checks for St Patrick's Day, Northern Ireland only
+func (cal UKCal) IsStPatricksDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// March 17th, possibly moved to Monday
+	return (d == 17 || ((d == 18 || d == 19) && w == time.Monday)) && m == time.March
+}
+
+//IsGoodFriday checks for Good Friday
+func (cal UKCal) IsGoodFriday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)-3
+}
+
+//IsEasterMonday checks for Easter Monday, not a bank holiday in Scotland
+func (cal UKCal) IsEasterMonday(y int, dd int) bool {
+	return dd == cal.EasterMonday(y)
+}
+
+//IsEarlyMayBankHoliday checks for the early May bank holiday
+func (cal UKCal) IsEarlyMayBankHoliday(y int, m time.Month, d int, w time.Weekday) bool {
+	if !EarlyMayBankHoliday.Covers(y) || m != time.May {
+		return false
+	}
+
+	switch y {
+	case 1995, 2020:
+		// moved to VE day, May 8th
+		return d == 8
+	}
+
+	// first Monday of May
+	return d <= 7 && w == time.Monday
+}
+
+//IsSpringBankHoliday checks for the spring bank holiday
+func (cal UKCal) IsSpringBankHoliday(y int, m time.Month, d int, w time.Weekday) bool {
+	// moved for the jubilees
+	switch y {
+	case 1977:
+		return m == time.June && d == 6
+	case 2002, 2012:
+		return m == time.June && d == 4
+	case 2022:
+		return m == time.June && d == 2
+	}
+
+	// last Monday of May
+	return d >= 25 && w == time.Monday && m == time.May
+}
+
+//IsBattleOfTheBoyne checks for the Battle of the Boyne, Northern Ireland only
+func (cal UKCal) IsBattleOfTheBoyne(y int, m time.Month, d int, w time.Weekday) bool {
+	// July 12th, possibly moved to Monday
+	return (d == 12 || ((d == 13 || d == 14) && w == time.Monday)) && m == time.July
+}
+
+//IsSummerBankHoliday checks for the summer bank holiday of England,
+//Wales and Northern Ireland
+func (cal UKCal) IsSummerBankHoliday(y int, m time.Month, d int, w time.Weekday) bool {
+	// last Monday of August
+	return d >= 25 && w == time.Monday && m == time.August
+}
+
+//IsSummerBankHolidayScotland checks for the Scottish summer bank holiday
+func (cal UKCal) IsSummerBankHolidayScotland(y int, m time.Month, d int, w time.Weekday) bool {
+	// first Monday of August
+	return d <= 7 && w == time.Monday && m == time.August
+}
+
+//IsStAndrewsDay checks for St Andrew's Day, Scotland only
+//It does not check the year, callers check the Effective span
+func (cal UKCal) IsStAndrewsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// November 30th, possibly moved to Monday
+	return (d == 30 && m == time.November) ||
+		((d == 1 || d == 2) && w == time.Monday && m == time.December)
+}
+
+//IsChristmas checks for Christmas
+func (cal UKCal) IsChristmas(y int, m time.Month, d int, w time.Weekday) bool {
+	// Christmas (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 25 || (d == 27 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsBoxingDay checks for Boxing Day
+func (cal UKCal) IsBoxingDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Boxing Day (possibly moved to Monday or Tuesday)
+	return m == time.December &&
+		(d == 26 || (d == 28 && (w == time.Monday || w == time.Tuesday)))
+}
+
+//IsOneOff checks for one-off bank holidays such as jubilees,
+//royal weddings and the 2022 state funeral
+func (cal UKCal) IsOneOff(t time.Time) bool {
+	_, ok := ukOneOffs[dateKey(t)]
+	return ok
+}
+
+//HolidayName names the bank holiday of England and Wales a day falls on
+func (cal UKCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEasterMonday(y, dd):
+		return "Easter Monday", true
+	case cal.IsEarlyMayBankHoliday(y, m, d, w):
+		return "Early May Bank Holiday", true
+	case cal.IsSpringBankHoliday(y, m, d, w):
+		return "Spring Bank Holiday", true
+	case cal.IsSummerBankHoliday(y, m, d, w):
+		return "Summer Bank Holiday", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsBoxingDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	if name, ok := ukOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//UKSettleCal, calendar for UK Settlement, the bank holidays of England and Wales
+//has all UKCal methods
+//It also satisfies BizCal interface
+type UKSettleCal struct {
+	UKCal
+}
+
+//IsBusinessDay checks for business day according to UK Settlement Calendar
+func (cal UKSettleCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//LSECal, calendar for London Stock Exchange
+//has all UKCal methods
+//It also satisfies BizCal interface
+type LSECal struct {
+	UKCal
+}
+
+//IsBusinessDay checks for business day according to LSE Calendar
+func (cal LSECal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//EarlyClose returns the close time in London when the exchange
+//closes early, at 12:30 p.m. on Christmas Eve and New Year's Eve
+func (cal LSECal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	_, m, d := t.Date()
+	if m == time.December && (d == 24 || d == 31) {
+		return closeAt(t, 12, 30, London), true
+	}
+
+	return time.Time{}, false
+}
+
+//UKGiltCal, calendar for the UK gilt market
+//Gilts settle in CREST on the bank holidays of England and Wales
+//has all UKCal methods
+//It also satisfies BizCal interface
+type UKGiltCal struct {
+	UKCal
+}
+
+//IsBusinessDay checks for business day according to UK gilt Calendar
+func (cal UKGiltCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//UKScotlandCal, calendar for the bank holidays of Scotland
+//has all UKCal methods
+//It also satisfies BizCal interface
+type UKScotlandCal struct {
+	UKCal
+}
+
+//IsBusinessDay checks for business day according to Scotland Calendar
+func (cal UKScotlandCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names the Scottish bank holiday a day falls on
+func (cal UKScotlandCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	dd := t.YearDay()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsSecondJanuary(y, m, d, w):
+		return "2nd January", true
+	case cal.IsGoodFriday(y, dd):
+		return "Good Friday", true
+	case cal.IsEarlyMayBankHoliday(y, m, d, w):
+		return "Early May Bank Holiday", true
+	case cal.IsSpringBankHoliday(y, m, d, w):
+		return "Spring Bank Holiday", true
+	case cal.IsSummerBankHolidayScotland(y, m, d, w):
+		return "Summer Bank Holiday", true
+	case StAndrewsDayBankHoliday.Covers(y) && cal.IsStAndrewsDay(y, m, d, w):
+		return "St Andrew's Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	case cal.IsBoxingDay(y, m, d, w):
+		return "Boxing Day", true
+	}
+
+	if name, ok := ukOneOffs[dateKey(t)]; ok {
+		return name, true
+	}
+
+	return "", false
+}
+
+//UKNorthernIrelandCal, calendar for the bank holidays of Northern Ireland
+//has all UKCal methods
+//It also satisfies BizCal interface
+type UKNorthernIrelandCal struct {
+	UKCal
+}
+
+//IsBusinessDay checks for business day according to Northern Ireland Calendar
+func (cal UKNorthernIrelandCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names the Northern Ireland bank holiday a day falls on
+func (cal UKNorthernIrelandCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case cal.IsStPatricksDay(y, m, d, w):
+		return "St Patrick's Day", true
+	case cal.IsBattleOfTheBoyne(y, m, d, w):
+		return "Battle of the Boyne", true
+	}
+
+	return cal.UKCal.HolidayName(t)
+}
@@ -0,0 +1,89 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUKBankHolidays(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		// substitute days
+		{time.Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{time.Date(2022, time.December, 26, 0, 0, 0, 0, time.UTC), "Boxing Day"},
+		{time.Date(2022, time.December, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		{time.Date(2021, time.December, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		{time.Date(2021, time.December, 28, 0, 0, 0, 0, time.UTC), "Boxing Day"},
+		// the early May bank holiday moved to VE day
+		{time.Date(1995, time.May, 8, 0, 0, 0, 0, time.UTC), "Early May Bank Holiday"},
+		{time.Date(1995, time.May, 1, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2020, time.May, 8, 0, 0, 0, 0, time.UTC), "Early May Bank Holiday"},
+		{time.Date(2020, time.May, 4, 0, 0, 0, 0, time.UTC), ""},
+		// the spring bank holiday moved for the jubilees
+		{time.Date(1977, time.June, 6, 0, 0, 0, 0, time.UTC), "Spring Bank Holiday"},
+		{time.Date(1977, time.June, 7, 0, 0, 0, 0, time.UTC), "Silver Jubilee of Elizabeth II"},
+		{time.Date(1977, time.May, 30, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2002, time.June, 3, 0, 0, 0, 0, time.UTC), "Golden Jubilee of Elizabeth II"},
+		{time.Date(2002, time.June, 4, 0, 0, 0, 0, time.UTC), "Spring Bank Holiday"},
+		{time.Date(2002, time.May, 27, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2012, time.June, 4, 0, 0, 0, 0, time.UTC), "Spring Bank Holiday"},
+		{time.Date(2012, time.June, 5, 0, 0, 0, 0, time.UTC), "Diamond Jubilee of Elizabeth II"},
+		{time.Date(2012, time.May, 28, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2022, time.June, 2, 0, 0, 0, 0, time.UTC), "Spring Bank Holiday"},
+		{time.Date(2022, time.June, 3, 0, 0, 0, 0, time.UTC), "Platinum Jubilee of Elizabeth II"},
+		{time.Date(2022, time.May, 30, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(1973, time.November, 14, 0, 0, 0, 0, time.UTC), "Wedding of Princess Anne and Mark Phillips"},
+	}
+	for _, tt := range tests {
+		if name, _ := (UKSettleCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("UKSettleCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+		if (LSECal{}).IsBusinessDay(tt.day) != (tt.name == "") {
+			t.Errorf("LSECal %s business day %v, want %v", tt.day.Format("2006-01-02"), tt.name != "", tt.name == "")
+		}
+	}
+}
+
+func TestUKScotland(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{time.Date(2022, time.January, 4, 0, 0, 0, 0, time.UTC), "2nd January"},
+		{time.Date(2021, time.January, 4, 0, 0, 0, 0, time.UTC), "2nd January"},
+		{time.Date(2017, time.January, 3, 0, 0, 0, 0, time.UTC), "2nd January"},
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.August, 5, 0, 0, 0, 0, time.UTC), "Summer Bank Holiday"},
+		{time.Date(2024, time.August, 26, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, time.December, 2, 0, 0, 0, 0, time.UTC), "St Andrew's Day"},
+		{time.Date(2006, time.November, 30, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(1977, time.June, 6, 0, 0, 0, 0, time.UTC), "Spring Bank Holiday"},
+	}
+	for _, tt := range tests {
+		if name, _ := (UKScotlandCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("UKScotlandCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
+
+func TestUKNorthernIreland(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		name string
+	}{
+		{time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC), "St Patrick's Day"},
+		{time.Date(2025, time.March, 17, 0, 0, 0, 0, time.UTC), "St Patrick's Day"},
+		{time.Date(2025, time.July, 14, 0, 0, 0, 0, time.UTC), "Battle of the Boyne"},
+		{time.Date(2024, time.July, 12, 0, 0, 0, 0, time.UTC), "Battle of the Boyne"},
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), "Easter Monday"},
+		{time.Date(2024, time.August, 26, 0, 0, 0, 0, time.UTC), "Summer Bank Holiday"},
+	}
+	for _, tt := range tests {
+		if name, _ := (UKNorthernIrelandCal{}).HolidayName(tt.day); name != tt.name {
+			t.Errorf("UKNorthernIrelandCal %s is %q, want %q", tt.day.Format("2006-01-02"), name, tt.name)
+		}
+	}
+}
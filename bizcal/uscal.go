@@ -39,6 +39,20 @@ type USCal struct {
 	BasicCal
 }
 
+//Effective years of US holidays that started or stopped being observed.
+//Juneteenth became a federal holiday on June 17, 2021 and federal offices
+//closed on Friday June 18, 2021 at two days' notice, but markets, SIFMA
+//and the Federal Reserve Banks stayed open that year and closed from 2022.
+var (
+	JuneteenthFederal = Effective{From: 2021}
+	JuneteenthSettle  = Effective{From: 2022}
+	JuneteenthLibor   = Effective{From: 2022}
+	JuneteenthGovBond = Effective{From: 2022}
+	JuneteenthFed     = Effective{From: 2022}
+	JuneteenthNYSE    = Effective{From: 2022}
+	MLKDayNYSE        = Effective{From: 1998}
+)
+
 //IsNewYearsDay checks if a particular day is on new year's day
 func (cal USCal) IsNewYearsDay(y int, m time.Month, d int, w time.Weekday) bool {
 	// New Year's Day
@@ -154,6 +168,59 @@ func (cal USCal) IsChristmas(y int, m time.Month, d int, w time.Weekday) bool {
 		(d == 24 && w == time.Friday))
 }
 
+//IsJuneteenth checks for Juneteenth National Independence Day
+//It does not check the year, callers check the Effective span
+func (cal USCal) IsJuneteenth(y int, m time.Month, d int, w time.Weekday) bool {
+	// June 19th, or as adjusted
+	return m == time.June && (d == 19 ||
+		(d == 20 && w == time.Monday) ||
+		(d == 18 && w == time.Friday))
+}
+
+//IsJuneteenthNoSaturday checks for Juneteenth
+//but no Saturday to Friday adjustment
+func (cal USCal) IsJuneteenthNoSaturday(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.June && (d == 19 ||
+		(d == 20 && w == time.Monday))
+}
+
+//HolidayName names the US holiday rule a day falls on
+//It does not check whether a particular calendar observes it
+func (cal USCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w) ||
+		(d == 31 && m == time.December && w == time.Friday):
+		return "New Year's Day", true
+	case cal.IsMLKDay(y, m, d, w):
+		return "Martin Luther King Jr. Day", true
+	case cal.IsPresidentsDay(y, m, d, w):
+		return "Presidents' Day", true
+	case cal.IsGoodFriday(y, t.YearDay()):
+		return "Good Friday", true
+	case cal.IsMemorialDay(y, m, d, w):
+		return "Memorial Day", true
+	case JuneteenthFederal.Covers(y) && cal.IsJuneteenth(y, m, d, w):
+		return "Juneteenth National Independence Day", true
+	case cal.IsIndependenceDay(y, m, d, w):
+		return "Independence Day", true
+	case cal.IsLaborDay(y, m, d, w):
+		return "Labor Day", true
+	case cal.IsColumbusDay(y, m, d, w):
+		return "Columbus Day", true
+	case cal.IsVeteransDay(y, m, d, w):
+		return "Veterans Day", true
+	case cal.IsThanksgiving(y, m, d, w):
+		return "Thanksgiving Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	}
+
+	return "", false
+}
+
 //BizCal interface, Business calendar
 type BizCal interface {
 	BaseCal
@@ -183,6 +250,7 @@ func (cal USSettleCal) IsBusinessDay(t time.Time) bool {
 		cal.IsMLKDay(y, m, d, w) ||
 		cal.IsPresidentsDay(y, m, d, w) ||
 		cal.IsMemorialDay(y, m, d, w) ||
+		(JuneteenthSettle.Covers(y) && cal.IsJuneteenth(y, m, d, w)) ||
 		cal.IsIndependenceDay(y, m, d, w) ||
 		cal.IsLaborDay(y, m, d, w) ||
 		cal.IsColumbusDay(y, m, d, w) ||
@@ -199,6 +267,11 @@ func (cal USSettleCal) IsBusinessDay(t time.Time) bool {
 //USLiborCal, calendar for US Libor
 //has all USCal methods
 //It also satisfies BizCal interface
+//
+//Deprecated: USD LIBOR panel publication ended on June 30, 2023 and the
+//synthetic settings on September 30, 2024. Use SOFRCal for SOFR fixings
+//and SIFMACal for the US bond market. USLiborCal is kept for
+//historical fixings and will be removed in a future release.
 type USLiborCal struct {
 	USCal
 }
@@ -212,12 +285,6 @@ func (cal USLiborCal) IsBusinessDay(t time.Time) bool {
 	y, m, d := t.Date()
 	w := t.Weekday()
 
-	// Since 2015 Independence Day only impacts Libor if it falls
-	// on a weekday
-	if m == time.July && y >= 2015 && d != 4 {
-		return true
-	}
-
 	if cal.IsNewYearsDay(y, m, d, w) ||
 		// only for US Settlement Calendar
 		// Preceeding 12/31 if 1/1 is on Saturday
@@ -225,7 +292,11 @@ func (cal USLiborCal) IsBusinessDay(t time.Time) bool {
 		cal.IsMLKDay(y, m, d, w) ||
 		cal.IsPresidentsDay(y, m, d, w) ||
 		cal.IsMemorialDay(y, m, d, w) ||
-		cal.IsIndependenceDay(y, m, d, w) ||
+		(JuneteenthLibor.Covers(y) && cal.IsJuneteenth(y, m, d, w)) ||
+		// Since 2015 Independence Day only impacts Libor if it falls
+		// on a weekday, it is not moved to Friday or Monday
+		(y >= 2015 && m == time.July && d == 4) ||
+		(y < 2015 && cal.IsIndependenceDay(y, m, d, w)) ||
 		cal.IsLaborDay(y, m, d, w) ||
 		cal.IsColumbusDay(y, m, d, w) ||
 		cal.IsVeteransDay(y, m, d, w) ||
@@ -258,8 +329,9 @@ func (cal USGovBondCal) IsBusinessDay(t time.Time) bool {
 	if cal.IsNewYearsDay(y, m, d, w) ||
 		cal.IsMLKDay(y, m, d, w) ||
 		cal.IsPresidentsDay(y, m, d, w) ||
-		(y != 2015 && cal.IsGoodFriday(y, dd)) ||
+		(!bondGoodFridayOpen[y] && cal.IsGoodFriday(y, dd)) ||
 		cal.IsMemorialDay(y, m, d, w) ||
+		(JuneteenthGovBond.Covers(y) && cal.IsJuneteenth(y, m, d, w)) ||
 		cal.IsIndependenceDay(y, m, d, w) ||
 		cal.IsLaborDay(y, m, d, w) ||
 		cal.IsColumbusDay(y, m, d, w) ||
@@ -270,16 +342,35 @@ func (cal USGovBondCal) IsBusinessDay(t time.Time) bool {
 		return false
 	}
 
-	// Special closings
-	if (y == 2018 && m == time.December && d == 5) || // President Bush's Funeral
-		// Hurricane Sandy
-		(y == 2012 && m == time.October && (d == 30)) ||
-		// President Reagan's funeral
-		(y == 2004 && m == time.June && d == 11) {
+	// Hurricane Sandy
+	if y == 2012 && m == time.October && d == 30 {
 		return false
 	}
 
-	return true
+	// closings by executive order the bond market observed, see USFederalClosures
+	c, closed := FederalClosure(t)
+	return !closed || !c.GovBond
+}
+
+//HolidayName names US Government bond holidays and special closings
+func (cal USGovBondCal) HolidayName(t time.Time) (string, bool) {
+	if cal.IsBusinessDay(t) {
+		return "", false
+	}
+
+	if name, ok := cal.USCal.HolidayName(t); ok {
+		return name, true
+	}
+
+	if cal.IsWeekend(t) {
+		return "", false
+	}
+
+	if c, ok := FederalClosure(t); ok {
+		return c.Name, true
+	}
+
+	return "Special closing", true
 }
 
 //USFedCal, calendar for US Settlement
@@ -302,6 +393,8 @@ func (cal USFedCal) IsBusinessDay(t time.Time) bool {
 		cal.IsMLKDay(y, m, d, w) ||
 		cal.IsPresidentsDay(y, m, d, w) ||
 		cal.IsMemorialDay(y, m, d, w) ||
+		// no 6/18 holiday if 6/19 is a Saturday
+		(JuneteenthFed.Covers(y) && cal.IsJuneteenthNoSaturday(y, m, d, w)) ||
 		// a little bit different for independence day
 		// no 7/3 holiday if 7/4 is a Saturday
 		(m == time.July && (d == 4 || (d == 5 && w == time.Monday))) ||
@@ -316,7 +409,22 @@ func (cal USFedCal) IsBusinessDay(t time.Time) bool {
 		return false
 	}
 
-	return true
+	// closings by executive order, see USFederalClosures
+	_, closed := FederalClosure(t)
+	return !closed
+}
+
+//HolidayName names Federal Reserve holidays and closings by executive order
+func (cal USFedCal) HolidayName(t time.Time) (string, bool) {
+	if cal.IsBusinessDay(t) || cal.IsWeekend(t) {
+		return "", false
+	}
+
+	if c, ok := FederalClosure(t); ok {
+		return c.Name, true
+	}
+
+	return cal.USCal.HolidayName(t)
 }
 
 //NYSECal, calendar for New York Stock Exchange
@@ -337,10 +445,11 @@ func (cal NYSECal) IsBusinessDay(t time.Time) bool {
 	dd := t.YearDay()
 
 	if cal.IsNewYearsDay(y, m, d, w) ||
-		(y >= 1998 && cal.IsMLKDay(y, m, d, w)) ||
+		(MLKDayNYSE.Covers(y) && cal.IsMLKDay(y, m, d, w)) ||
 		cal.IsPresidentsDay(y, m, d, w) ||
 		cal.IsGoodFriday(y, dd) ||
 		cal.IsMemorialDay(y, m, d, w) ||
+		(JuneteenthNYSE.Covers(y) && cal.IsJuneteenth(y, m, d, w)) ||
 		cal.IsIndependenceDay(y, m, d, w) ||
 		cal.IsLaborDay(y, m, d, w) ||
 		cal.IsThanksgiving(y, m, d, w) ||
@@ -356,8 +465,10 @@ func (cal NYSECal) IsBusinessDay(t time.Time) bool {
 	}
 
 	// Special closings
-	if // President Bush's Funeral
-	(y == 2018 && m == time.December && d == 5) ||
+	if // National Day of Mourning for President Carter
+	(y == 2025 && m == time.January && d == 9) ||
+		// President Bush's Funeral
+		(y == 2018 && m == time.December && d == 5) ||
 		// Hurricane Sandy
 		(y == 2012 && m == time.October && (d == 29 || d == 30)) ||
 		// President Ford's funeral
@@ -403,8 +514,78 @@ func (cal NYSECal) IsBusinessDay(t time.Time) bool {
 	return true
 }
 
+//HolidayName names NYSE holidays, presidential election days and special closings
+func (cal NYSECal) HolidayName(t time.Time) (string, bool) {
+	if cal.IsBusinessDay(t) || cal.IsWeekend(t) {
+		return "", false
+	}
+
+	if name, ok := cal.USCal.HolidayName(t); ok {
+		return name, true
+	}
+
+	if t.Month() == time.November && t.Weekday() == time.Tuesday {
+		return "Presidential Election Day", true
+	}
+
+	return "Special closing", true
+}
+
 // End QuantLib code adaptation
 
+//nyseEarlyCloses lists the ad hoc NYSE early closes
+//as hour and minute in New York
+var nyseEarlyCloses = map[time.Time][2]int{
+	// Day after Christmas
+	time.Date(1997, time.December, 26, 0, 0, 0, 0, time.UTC): {13, 0},
+	time.Date(2003, time.December, 26, 0, 0, 0, 0, time.UTC): {13, 0},
+}
+
+//EarlyClose returns the close time in New York when the exchange
+//closes early on a particular day
+func (cal NYSECal) EarlyClose(t time.Time) (time.Time, bool) {
+	if !cal.IsBusinessDay(t) {
+		return time.Time{}, false
+	}
+
+	if hm, ok := nyseEarlyCloses[dateKey(t)]; ok {
+		return closeAt(t, hm[0], hm[1], NewYork), true
+	}
+
+	y, m, d := t.Date()
+	w := t.Weekday()
+
+	// July 3rd, Monday, Tuesday and Thursday since 1995, Wednesday since 2013
+	if m == time.July && d == 3 &&
+		((y >= 1995 && (w == time.Monday || w == time.Tuesday || w == time.Thursday)) ||
+			(y >= 2013 && w == time.Wednesday)) {
+		return closeAt(t, 13, 0, NewYork), true
+	}
+
+	// Friday July 5th after a Thursday Independence Day, 1996 to 2012
+	if m == time.July && d == 5 && w == time.Friday && y >= 1996 && y <= 2012 {
+		return closeAt(t, 13, 0, NewYork), true
+	}
+
+	// day after Thanksgiving, 2:00 p.m. before 1993
+	prev := t.AddDate(0, 0, -1)
+	py, pm, pd := prev.Date()
+	if cal.IsThanksgiving(py, pm, pd, prev.Weekday()) {
+		if y >= 1993 {
+			return closeAt(t, 13, 0, NewYork), true
+		}
+		return closeAt(t, 14, 0, NewYork), true
+	}
+
+	// Christmas Eve, Monday to Thursday since 1999
+	if m == time.December && d == 24 && y >= 1999 &&
+		w >= time.Monday && w <= time.Thursday {
+		return closeAt(t, 13, 0, NewYork), true
+	}
+
+	return time.Time{}, false
+}
+
 //AdjForBusinessDay take one date and either returns itself
 //if it is already a business day
 //or returns the next business day
@@ -458,3 +639,40 @@ func PrevBusinessDay(cal BizCal, t time.Time) time.Time {
 		}
 	}
 }
+
+//AddBusinessDays moves n business days from a date
+//forward when n is positive and backward when n is negative
+//with n equal to zero it adjusts to the next business day
+func AddBusinessDays(cal BizCal, t time.Time, n int) time.Time {
+	if n == 0 {
+		return AdjForBusinessDay(cal, t)
+	}
+
+	rt := t
+	for ; n > 0; n-- {
+		rt = NextBusinessDay(cal, rt)
+	}
+	for ; n < 0; n++ {
+		rt = PrevBusinessDay(cal, rt)
+	}
+
+	return rt
+}
+
+//BusinessDaysBetween counts the business days from one date
+//up to but not including another date
+//the count is negative when from is after to
+func BusinessDaysBetween(cal BizCal, from, to time.Time) int {
+	if from.After(to) {
+		return -BusinessDaysBetween(cal, to, from)
+	}
+
+	n := 0
+	for rt := from; rt.Before(to); rt = rt.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(rt) {
+			n++
+		}
+	}
+
+	return n
+}
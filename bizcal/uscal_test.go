@@ -0,0 +1,124 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJuneteenth(t *testing.T) {
+	// the weekday each calendar closes for Juneteenth, 0 for none
+	// 2021 fell on a Saturday, observed by federal offices only,
+	// 2022 on a Sunday and 2027 on a Saturday, which the Fed does not move to Friday
+	tests := []struct {
+		year                              int
+		settle, libor, govBond, fed, nyse int
+	}{
+		{2021, 0, 0, 0, 0, 0},
+		{2022, 20, 20, 20, 20, 20},
+		{2023, 19, 19, 19, 19, 19},
+		{2024, 19, 19, 19, 19, 19},
+		{2025, 19, 19, 19, 19, 19},
+		{2026, 19, 19, 19, 19, 19},
+		{2027, 18, 18, 18, 0, 18},
+		{2028, 19, 19, 19, 19, 19},
+		{2029, 19, 19, 19, 19, 19},
+		{2030, 19, 19, 19, 19, 19},
+	}
+
+	for _, tt := range tests {
+		cals := []struct {
+			name string
+			cal  BizCal
+			day  int
+		}{
+			{"USSettleCal", USSettleCal{}, tt.settle},
+			{"USLiborCal", USLiborCal{}, tt.libor},
+			{"USGovBondCal", USGovBondCal{}, tt.govBond},
+			{"USFedCal", USFedCal{}, tt.fed},
+			{"NYSECal", NYSECal{}, tt.nyse},
+		}
+		for _, c := range cals {
+			for d := 17; d <= 21; d++ {
+				day := time.Date(tt.year, time.June, d, 0, 0, 0, 0, time.UTC)
+				if c.cal.IsWeekend(day) {
+					continue
+				}
+				if want := d != c.day; c.cal.IsBusinessDay(day) != want {
+					t.Errorf("%s.IsBusinessDay(%s) = %v, want %v", c.name, day.Format("2006-01-02"), !want, want)
+				}
+			}
+		}
+	}
+
+	if name, ok := (USCal{}).HolidayName(time.Date(2021, time.June, 18, 0, 0, 0, 0, time.UTC)); !ok || name != "Juneteenth National Independence Day" {
+		t.Errorf("USCal 2021-06-18 is %q, want Juneteenth National Independence Day", name)
+	}
+}
+
+func TestNYSEHolidayNameWhenOpen(t *testing.T) {
+	// federal holidays the exchange trades through are not NYSE holidays
+	nyse := NYSECal{}
+	for _, day := range []time.Time{
+		time.Date(2024, time.October, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.November, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.June, 18, 0, 0, 0, 0, time.UTC),
+	} {
+		if !nyse.IsBusinessDay(day) {
+			t.Errorf("NYSE %s closed, want open", day.Format("2006-01-02"))
+		}
+		if name, ok := nyse.HolidayName(day); ok {
+			t.Errorf("NYSE %s is %q, want no holiday", day.Format("2006-01-02"), name)
+		}
+	}
+	if name, _ := nyse.HolidayName(time.Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)); name != "Independence Day" {
+		t.Errorf("NYSE 2024-07-04 is %q, want Independence Day", name)
+	}
+}
+
+func TestNYSEEarlyClose(t *testing.T) {
+	// the hour in New York, 0 for a full day or a closed day
+	tests := []struct {
+		day  time.Time
+		hour int
+	}{
+		// day after Thanksgiving, 2:00 p.m. before 1993
+		{time.Date(2024, time.November, 29, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(1993, time.November, 26, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(1990, time.November, 23, 0, 0, 0, 0, time.UTC), 14},
+		{time.Date(2024, time.November, 28, 0, 0, 0, 0, time.UTC), 0},
+		// Christmas Eve, Monday to Thursday since 1999
+		{time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2020, time.December, 24, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2021, time.December, 24, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2023, time.December, 24, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(1998, time.December, 24, 0, 0, 0, 0, time.UTC), 0},
+		// July 3rd, Wednesday only since 2013
+		{time.Date(2024, time.July, 3, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2025, time.July, 3, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2023, time.July, 3, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2002, time.July, 3, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2020, time.July, 3, 0, 0, 0, 0, time.UTC), 0},
+		// Friday July 5th after a Thursday Independence Day
+		{time.Date(2002, time.July, 5, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2019, time.July, 5, 0, 0, 0, 0, time.UTC), 0},
+		// ad hoc closes
+		{time.Date(1997, time.December, 26, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2003, time.December, 26, 0, 0, 0, 0, time.UTC), 13},
+		{time.Date(2008, time.December, 26, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		c, ok := (NYSECal{}).EarlyClose(tt.day)
+		if ok != (tt.hour != 0) {
+			t.Errorf("NYSECal.EarlyClose(%s) = %v, want %v", tt.day.Format("2006-01-02"), ok, tt.hour != 0)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		y, m, d := tt.day.Date()
+		if want := time.Date(y, m, d, tt.hour, 0, 0, 0, NewYork); !c.Equal(want) {
+			t.Errorf("NYSECal.EarlyClose(%s) = %s, want %s", tt.day.Format("2006-01-02"), c, want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package bizcal
+
+import (
+	"time"
+)
+
+//Closure, an ad hoc closing of federal executive departments and agencies
+//together with where it came from and when it was announced
+//GovBond is set when the US Government bond market closed too
+type Closure struct {
+	Date      time.Time
+	Name      string
+	Source    string
+	Announced time.Time
+	GovBond   bool
+}
+
+//USFederalClosures lists the ad hoc federal closings ordered by the President,
+//it is data only, the recurring holiday rules live on USCal
+//USFedCal closes on all of them, markets decide for themselves,
+//USGovBondCal closes on the ones marked GovBond and
+//NYSECal lists the days it closed as special closings
+//Source names the executive order by its signing date and title
+//New closings are added here as they are announced
+var USFederalClosures = []Closure{
+	{
+		Date:      time.Date(2001, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 20, 2001, Providing for the Closing of Executive Departments and Agencies of the Federal Government on Monday, December 24, 2001",
+		Announced: time.Date(2001, time.December, 20, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2004, time.June, 11, 0, 0, 0, 0, time.UTC),
+		Name:      "National Day of Mourning for President Reagan",
+		Source:    "Executive Order of June 7, 2004, Providing for the Closing of Executive Departments and Agencies of the Federal Government on June 11, 2004",
+		Announced: time.Date(2004, time.June, 7, 0, 0, 0, 0, time.UTC),
+		GovBond:   true,
+	},
+	{
+		Date:      time.Date(2007, time.January, 2, 0, 0, 0, 0, time.UTC),
+		Name:      "National Day of Mourning for President Ford",
+		Source:    "Executive Order of December 28, 2006, Providing for the Closing of Executive Departments and Agencies of the Federal Government on January 2, 2007",
+		Announced: time.Date(2006, time.December, 28, 0, 0, 0, 0, time.UTC),
+		GovBond:   true,
+	},
+	{
+		Date:      time.Date(2007, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 7, 2007, Providing for the Closing of Executive Departments and Agencies of the Federal Government on Monday, December 24, 2007",
+		Announced: time.Date(2007, time.December, 7, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2008, time.December, 26, 0, 0, 0, 0, time.UTC),
+		Name:      "Day after Christmas",
+		Source:    "Executive Order of December 12, 2008, Providing for the Closing of Executive Departments and Agencies of the Federal Government on Friday, December 26, 2008",
+		Announced: time.Date(2008, time.December, 12, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2012, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 21, 2012, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 24, 2012",
+		Announced: time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2014, time.December, 26, 0, 0, 0, 0, time.UTC),
+		Name:      "Day after Christmas",
+		Source:    "Executive Order of December 5, 2014, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 26, 2014",
+		Announced: time.Date(2014, time.December, 5, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2018, time.December, 5, 0, 0, 0, 0, time.UTC),
+		Name:      "National Day of Mourning for President George H. W. Bush",
+		Source:    "Executive Order of December 1, 2018, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 5, 2018",
+		Announced: time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC),
+		GovBond:   true,
+	},
+	{
+		Date:      time.Date(2018, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 18, 2018, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 24, 2018",
+		Announced: time.Date(2018, time.December, 18, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2019, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 17, 2019, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 24, 2019",
+		Announced: time.Date(2019, time.December, 17, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2020, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 11, 2020, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 24, 2020",
+		Announced: time.Date(2020, time.December, 11, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC),
+		Name:      "Christmas Eve",
+		Source:    "Executive Order of December 19, 2024, Providing for the Closing of Executive Departments and Agencies of the Federal Government on December 24, 2024",
+		Announced: time.Date(2024, time.December, 19, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Date:      time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC),
+		Name:      "National Day of Mourning for President Carter",
+		Source:    "Executive Order of December 30, 2024, Providing for the Closing of Executive Departments and Agencies of the Federal Government on January 9, 2025",
+		Announced: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+//FederalClosure looks up the ad hoc federal closing on a day
+func FederalClosure(t time.Time) (Closure, bool) {
+	key := dateKey(t)
+	for _, c := range USFederalClosures {
+		if dateKey(c.Date).Equal(key) {
+			return c, true
+		}
+	}
+
+	return Closure{}, false
+}
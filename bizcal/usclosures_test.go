@@ -0,0 +1,49 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFederalClosure(t *testing.T) {
+	// the closings by executive order named in the request,
+	// the bond market closed early on them but did not close
+	for _, day := range []time.Time{
+		time.Date(2019, time.December, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.December, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC),
+	} {
+		c, ok := FederalClosure(day.Add(15 * time.Hour))
+		if !ok {
+			t.Errorf("FederalClosure(%s) not found", day.Format("2006-01-02"))
+			continue
+		}
+		if c.Source == "" || c.Announced.IsZero() || !c.Announced.Before(c.Date) {
+			t.Errorf("FederalClosure(%s) = %+v, want a source announced before the day", day.Format("2006-01-02"), c)
+		}
+		if (USFedCal{}).IsBusinessDay(day) {
+			t.Errorf("USFed %s open, want closed", day.Format("2006-01-02"))
+		}
+		if name, _ := (USFedCal{}).HolidayName(day); name != c.Name {
+			t.Errorf("USFed %s is %q, want %q", day.Format("2006-01-02"), name, c.Name)
+		}
+		if !(USGovBondCal{}).IsBusinessDay(day) {
+			t.Errorf("USGovBond %s closed, want open", day.Format("2006-01-02"))
+		}
+	}
+
+	if _, ok := FederalClosure(time.Date(2024, time.December, 23, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("FederalClosure(2024-12-23) found, want none")
+	}
+
+	// the days of mourning the bond market closed for come from the closures
+	for _, c := range USFederalClosures {
+		if got := (USGovBondCal{}).IsBusinessDay(c.Date); got == c.GovBond {
+			t.Errorf("USGovBond %s business day %v, want %v", c.Date.Format("2006-01-02"), got, !c.GovBond)
+		}
+	}
+	if name, _ := (USGovBondCal{}).HolidayName(time.Date(2018, time.December, 5, 0, 0, 0, 0, time.UTC)); name != "National Day of Mourning for President George H. W. Bush" {
+		t.Errorf("USGovBond 2018-12-05 is %q, want the day of mourning", name)
+	}
+}
@@ -0,0 +1,275 @@
+package bizcal
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+//Effective years of US state holidays
+var (
+	CesarChavezDayCA    = Effective{From: 2001}
+	NativeAmericanDayCA = Effective{From: 1998}
+	EmancipationDayDC   = Effective{From: 2005}
+	JuneteenthNY        = Effective{From: 2020}
+	JuneteenthMA        = Effective{From: 2021}
+	JuneteenthIL        = Effective{From: 2022}
+	JuneteenthME        = Effective{From: 2022}
+	JuneteenthCT        = Effective{From: 2023}
+	PatriotsDayMonday   = Effective{From: 1969}
+	InaugurationDayDC   = Effective{From: 1965}
+	DayAfterChristmasTX = Effective{From: 1991}
+)
+
+//USStates maps the state codes with their own rule sets to state names
+//other codes get the federal holidays only
+var USStates = map[string]string{
+	"CA": "California",
+	"CT": "Connecticut",
+	"DC": "District of Columbia",
+	"IL": "Illinois",
+	"MA": "Massachusetts",
+	"ME": "Maine",
+	"NY": "New York",
+	"TX": "Texas",
+}
+
+func init() {
+	codes := make([]string, 0, len(USStates))
+	for code := range USStates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		Register("US-"+code, USStateCal{State: code})
+	}
+}
+
+//IsLincolnsBirthday checks for Lincoln's Birthday
+func (cal USCal) IsLincolnsBirthday(y int, m time.Month, d int, w time.Weekday) bool {
+	// February 12th, or as adjusted
+	return m == time.February && (d == 12 ||
+		(d == 13 && w == time.Monday) ||
+		(d == 11 && w == time.Friday))
+}
+
+//IsCesarChavezDay checks for Cesar Chavez Day
+func (cal USCal) IsCesarChavezDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// March 31st, moved to Monday when it falls on a Sunday
+	return (m == time.March && d == 31) ||
+		(m == time.April && d == 1 && w == time.Monday)
+}
+
+//IsNativeAmericanDay checks for California Native American Day
+func (cal USCal) IsNativeAmericanDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// fourth Friday in September
+	return m == time.September && (d >= 22 && d <= 28) && w == time.Friday
+}
+
+//IsPatriotsDay checks for Patriots' Day
+func (cal USCal) IsPatriotsDay(y int, m time.Month, d int, w time.Weekday) bool {
+	if PatriotsDayMonday.Covers(y) {
+		// third Monday in April
+		return m == time.April && (d >= 15 && d <= 21) && w == time.Monday
+	}
+
+	// April 19th
+	return m == time.April && d == 19
+}
+
+//IsTexasIndependenceDay checks for Texas Independence Day, March 2nd
+func (cal USCal) IsTexasIndependenceDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.March && d == 2
+}
+
+//IsSanJacintoDay checks for San Jacinto Day, April 21st
+func (cal USCal) IsSanJacintoDay(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.April && d == 21
+}
+
+//IsEmancipationDayDC checks for District of Columbia Emancipation Day
+func (cal USCal) IsEmancipationDayDC(y int, m time.Month, d int, w time.Weekday) bool {
+	// April 16th, or as adjusted
+	return m == time.April && (d == 16 ||
+		(d == 17 && w == time.Monday) ||
+		(d == 15 && w == time.Friday))
+}
+
+//IsInaugurationDay checks for Inauguration Day, a holiday in the District of Columbia
+func (cal USCal) IsInaugurationDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// January 20th after a presidential election, Monday the 21st if the 20th is a Sunday
+	return (y-1965)%4 == 0 && m == time.January &&
+		((d == 20 && w != time.Sunday) || (d == 21 && w == time.Monday))
+}
+
+//IsElectionDay checks for general election day
+func (cal USCal) IsElectionDay(y int, m time.Month, d int, w time.Weekday) bool {
+	// Tuesday after the first Monday in November
+	return m == time.November && (d >= 2 && d <= 8) && w == time.Tuesday
+}
+
+//IsDayAfterThanksgiving checks for the Friday after Thanksgiving
+func (cal USCal) IsDayAfterThanksgiving(y int, m time.Month, d int, w time.Weekday) bool {
+	return m == time.November && w == time.Friday && (d >= 23 && d <= 29)
+}
+
+//USStateCal, calendar of the legal holidays on which the offices
+//of one state government close, keyed by state code such as "CA",
+//see USStates. Holidays on which offices stay open with partial
+//staffing, such as Texas Independence Day, are only included when
+//PartialStaffing is set.
+//has all USCal methods
+//It also satisfies BizCal interface
+type USStateCal struct {
+	USCal
+	State           string
+	PartialStaffing bool
+}
+
+//IsBusinessDay checks for business day according to the state calendar
+func (cal USStateCal) IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+
+	_, ok := cal.HolidayName(t)
+	return !ok
+}
+
+//HolidayName names the state holiday a day falls on
+func (cal USStateCal) HolidayName(t time.Time) (string, bool) {
+	y, m, d := t.Date()
+	w := t.Weekday()
+	state := strings.ToUpper(cal.State)
+
+	// federal holidays kept by every state
+	switch {
+	case cal.IsNewYearsDay(y, m, d, w):
+		return "New Year's Day", true
+	case cal.IsMLKDay(y, m, d, w):
+		return "Martin Luther King Jr. Day", true
+	case cal.IsPresidentsDay(y, m, d, w):
+		return "Presidents' Day", true
+	case cal.IsMemorialDay(y, m, d, w):
+		return "Memorial Day", true
+	case cal.IsIndependenceDay(y, m, d, w):
+		return "Independence Day", true
+	case cal.IsLaborDay(y, m, d, w):
+		return "Labor Day", true
+	case cal.IsVeteransDay(y, m, d, w):
+		return "Veterans Day", true
+	case cal.IsThanksgiving(y, m, d, w):
+		return "Thanksgiving Day", true
+	case cal.IsChristmas(y, m, d, w):
+		return "Christmas Day", true
+	}
+
+	columbusDay := cal.IsColumbusDay(y, m, d, w)
+	juneteenth := cal.IsJuneteenth(y, m, d, w)
+	lincolnsBirthday := cal.IsLincolnsBirthday(y, m, d, w)
+	dayAfterThanksgiving := cal.IsDayAfterThanksgiving(y, m, d, w)
+
+	switch state {
+	case "CA":
+		switch {
+		case CesarChavezDayCA.Covers(y) && cal.IsCesarChavezDay(y, m, d, w):
+			return "Cesar Chavez Day", true
+		case NativeAmericanDayCA.Covers(y) && cal.IsNativeAmericanDay(y, m, d, w):
+			return "Native American Day", true
+		case dayAfterThanksgiving:
+			return "Day after Thanksgiving", true
+		}
+	case "CT":
+		switch {
+		case lincolnsBirthday:
+			return "Lincoln's Birthday", true
+		case cal.IsGoodFriday(y, t.YearDay()):
+			return "Good Friday", true
+		case JuneteenthCT.Covers(y) && juneteenth:
+			return "Juneteenth", true
+		case columbusDay:
+			return "Columbus Day", true
+		}
+	case "DC":
+		switch {
+		case InaugurationDayDC.Covers(y) && cal.IsInaugurationDay(y, m, d, w):
+			return "Inauguration Day", true
+		case EmancipationDayDC.Covers(y) && cal.IsEmancipationDayDC(y, m, d, w):
+			return "DC Emancipation Day", true
+		case JuneteenthFederal.Covers(y) && juneteenth:
+			return "Juneteenth National Independence Day", true
+		case columbusDay:
+			return "Columbus Day", true
+		}
+	case "IL":
+		switch {
+		case lincolnsBirthday:
+			return "Lincoln's Birthday", true
+		case JuneteenthIL.Covers(y) && juneteenth:
+			return "Juneteenth", true
+		case columbusDay:
+			return "Columbus Day", true
+		case y%2 == 0 && cal.IsElectionDay(y, m, d, w):
+			return "General Election Day", true
+		case dayAfterThanksgiving:
+			return "Day after Thanksgiving", true
+		}
+	case "MA", "ME":
+		switch {
+		case cal.IsPatriotsDay(y, m, d, w):
+			return "Patriots' Day", true
+		case state == "MA" && JuneteenthMA.Covers(y) && juneteenth:
+			return "Juneteenth", true
+		case state == "ME" && JuneteenthME.Covers(y) && juneteenth:
+			return "Juneteenth", true
+		case columbusDay:
+			return "Columbus Day", true
+		}
+	case "NY":
+		switch {
+		case lincolnsBirthday:
+			return "Lincoln's Birthday", true
+		case JuneteenthNY.Covers(y) && juneteenth:
+			return "Juneteenth", true
+		case columbusDay:
+			return "Columbus Day", true
+		}
+	case "TX":
+		partial := cal.PartialStaffing
+		switch {
+		case dayAfterThanksgiving:
+			return "Day after Thanksgiving", true
+		case m == time.December && d == 24:
+			return "Christmas Eve", true
+		case DayAfterChristmasTX.Covers(y) && m == time.December && d == 26:
+			return "Day after Christmas", true
+		case partial && m == time.January && d == 19:
+			return "Confederate Heroes Day", true
+		case partial && cal.IsTexasIndependenceDay(y, m, d, w):
+			return "Texas Independence Day", true
+		case partial && cal.IsSanJacintoDay(y, m, d, w):
+			return "San Jacinto Day", true
+		case partial && m == time.June && d == 19:
+			return "Emancipation Day", true
+		case partial && m == time.August && d == 27:
+			return "Lyndon Baines Johnson Day", true
+		}
+	default:
+		switch {
+		case JuneteenthFederal.Covers(y) && juneteenth:
+			return "Juneteenth National Independence Day", true
+		case columbusDay:
+			return "Columbus Day", true
+		}
+	}
+
+	return "", false
+}
+
+//TaxDeadline rolls a tax due date forward under a state calendar and the
+//District of Columbia legal holidays, which govern federal due dates,
+//so a deadline is only met on a day both the state and the IRS are open
+func TaxDeadline(state string, t time.Time) time.Time {
+	return RollDeadline(t, USStateCal{State: state}, USStateCal{State: "DC"})
+}
@@ -0,0 +1,48 @@
+package bizcal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNativeAmericanDay(t *testing.T) {
+	// the fourth Friday of September, a California state holiday
+	ca := USStateCal{State: "CA"}
+	for _, day := range []time.Time{
+		time.Date(2023, time.September, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.September, 27, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.September, 26, 0, 0, 0, 0, time.UTC),
+	} {
+		if name, _ := ca.HolidayName(day); name != "Native American Day" {
+			t.Errorf("CA %s is %q, want Native American Day", day.Format("2006-01-02"), name)
+		}
+	}
+	if name, ok := ca.HolidayName(time.Date(2024, time.September, 20, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("CA 2024-09-20 is %q, want no holiday", name)
+	}
+	if _, ok := (USStateCal{State: "NY"}).HolidayName(time.Date(2024, time.September, 27, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("NY 2024-09-27 is a holiday, want Native American Day in California only")
+	}
+}
+
+func TestTexasPartialStaffing(t *testing.T) {
+	// Texas Independence Day on a Monday, offices stay open with partial staffing
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	if !(USStateCal{State: "TX"}).IsBusinessDay(day) {
+		t.Errorf("TX 2026-03-02 closed, want open without PartialStaffing")
+	}
+	if name, _ := (USStateCal{State: "TX", PartialStaffing: true}).HolidayName(day); name != "Texas Independence Day" {
+		t.Errorf("TX 2026-03-02 with PartialStaffing is %q, want Texas Independence Day", name)
+	}
+	if name, _ := (USStateCal{State: "TX"}).HolidayName(time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)); name != "Christmas Eve" {
+		t.Errorf("TX 2025-12-24 is %q, want Christmas Eve", name)
+	}
+}
+
+func TestTaxDeadline(t *testing.T) {
+	// DC Emancipation Day on Monday, April 16th, 2018 moved the filing deadline
+	due := time.Date(2018, time.April, 15, 0, 0, 0, 0, time.UTC)
+	if got := TaxDeadline("CA", due); !got.Equal(time.Date(2018, time.April, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TaxDeadline(CA, 2018-04-15) = %s, want 2018-04-17", got.Format("2006-01-02"))
+	}
+}
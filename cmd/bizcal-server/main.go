@@ -0,0 +1,30 @@
+//Command bizcal-server serves the bizcal calendars over HTTP with JSON
+//
+//	bizcal-server --addr 127.0.0.1:8080
+//
+//See package server for the endpoints
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/genghongchen/cal/server"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8080", "listen address")
+	flag.Parse()
+
+	srv := &http.Server{
+		Addr:         *addr,
+		Handler:      server.New(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	log.Printf("bizcal-server listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
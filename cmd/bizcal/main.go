@@ -0,0 +1,361 @@
+//Command bizcal answers business day questions from the shell
+//
+//	bizcal is-business-day --cal XNYS 2027-11-26
+//	bizcal next --cal XNYS 2027-11-26
+//	bizcal prev --cal XNYS 2027-11-26
+//	bizcal advance --cal XNYS --days 3 2027-11-26
+//	bizcal between --cal XNYS 2027-11-01 2027-12-01
+//	bizcal holidays --cal XNYS --year 2027
+//	bizcal list-calendars
+//
+//Every subcommand takes --format text, json or csv
+//
+//Dates must fall in the years the calendar supports,
+//other dates exit with status 1 before anything is computed,
+//unknown commands and flags exit with status 2
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/genghongchen/cal/bizcal"
+)
+
+const dateLayout = "2006-01-02"
+
+//table is the output of one subcommand
+//list tables print as a JSON array, others as a single object
+type table struct {
+	cols []string
+	rows [][]interface{}
+	list bool
+}
+
+type command struct {
+	usage string
+	run   func(fs *flag.FlagSet, cal *string, args []string) (table, error)
+}
+
+var commands = map[string]command{
+	"is-business-day": {"is-business-day --cal NAME DATE", isBusinessDay},
+	"next":            {"next --cal NAME DATE", next},
+	"prev":            {"prev --cal NAME DATE", prev},
+	"advance":         {"advance --cal NAME --days N DATE", advance},
+	"between":         {"between --cal NAME FROM TO", between},
+	"holidays":        {"holidays --cal NAME (--year YYYY | --from DATE --to DATE)", holidays},
+	"list-calendars":  {"list-calendars", listCalendars},
+}
+
+var commandOrder = []string{
+	"is-business-day", "next", "prev", "advance", "between", "holidays", "list-calendars",
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+//run executes one command line and returns the exit status
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "bizcal: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprintf(stderr, "usage: bizcal %s [--format text|json|csv]\n", cmd.usage) }
+	cal := fs.String("cal", "", "calendar name, see list-calendars")
+	format := fs.String("format", "text", "output format: text, json or csv")
+
+	t, err := cmd.run(fs, cal, args[1:])
+	if err == flag.ErrHelp || err == errFlags {
+		return 2
+	}
+	if err != nil {
+		// errors of the bizcal package carry the same prefix
+		fmt.Fprintf(stderr, "bizcal: %s\n", strings.TrimPrefix(err.Error(), "bizcal: "))
+		return 1
+	}
+
+	if err := write(stdout, *format, t); err != nil {
+		fmt.Fprintf(stderr, "bizcal: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: bizcal COMMAND [flags] [args]")
+	fmt.Fprintln(w, "commands:")
+	for _, name := range commandOrder {
+		fmt.Fprintf(w, "  %s\n", commands[name].usage)
+	}
+}
+
+//formats lists the values of --format
+var formats = map[string]bool{"text": true, "json": true, "csv": true}
+
+//errFlags reports flags the flag package rejected, it has printed the error and the usage
+var errFlags = errors.New("invalid flags")
+
+//parse parses the flags and checks --format and the number of positional arguments
+func parse(fs *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil, err
+		}
+		return nil, errFlags
+	}
+
+	if f := fs.Lookup("format").Value.String(); !formats[f] {
+		return nil, fmt.Errorf("unknown format %q, want text, json or csv", f)
+	}
+
+	if fs.NArg() != nargs {
+		fs.Usage()
+		return nil, fmt.Errorf("%s takes %d argument(s), got %d", fs.Name(), nargs, fs.NArg())
+	}
+
+	return fs.Args(), nil
+}
+
+func lookup(name string) (bizcal.BizCal, error) {
+	if name == "" {
+		return nil, errors.New("missing --cal, see list-calendars")
+	}
+
+	cal, ok := bizcal.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown calendar %q, see list-calendars", name)
+	}
+
+	return cal, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return t, fmt.Errorf("invalid date %q, want YYYY-MM-DD", s)
+	}
+
+	return t, nil
+}
+
+//calAndDates parses the flags, the calendar and every positional date
+func calAndDates(fs *flag.FlagSet, calName *string, args []string, ndates int) (bizcal.BizCal, []time.Time, error) {
+	pos, err := parse(fs, args, ndates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cal, err := lookup(*calName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dates := make([]time.Time, len(pos))
+	for i, s := range pos {
+		if dates[i], err = parseDate(s); err != nil {
+			return nil, nil, err
+		}
+		if err = bizcal.CheckYear(cal, dates[i].Year()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return cal, dates, nil
+}
+
+//walkResult is the table of a walk, or an error if it left the supported years
+func walkResult(b *bizcal.Bounded, from, to time.Time) (table, error) {
+	if err := b.Err(); err != nil {
+		return table{}, err
+	}
+
+	return dateResult(from, to), nil
+}
+
+func isBusinessDay(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	cal, dates, err := calAndDates(fs, calName, args, 1)
+	if err != nil {
+		return table{}, err
+	}
+
+	return table{
+		cols: []string{"date", "business_day"},
+		rows: [][]interface{}{{dates[0].Format(dateLayout), cal.IsBusinessDay(dates[0])}},
+	}, nil
+}
+
+func next(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	cal, dates, err := calAndDates(fs, calName, args, 1)
+	if err != nil {
+		return table{}, err
+	}
+
+	b := bizcal.NewBounded(cal)
+	return walkResult(b, dates[0], bizcal.NextBusinessDay(b, dates[0]))
+}
+
+func prev(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	cal, dates, err := calAndDates(fs, calName, args, 1)
+	if err != nil {
+		return table{}, err
+	}
+
+	b := bizcal.NewBounded(cal)
+	return walkResult(b, dates[0], bizcal.PrevBusinessDay(b, dates[0]))
+}
+
+func advance(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	days := fs.Int("days", 1, "business days to move, negative moves backward")
+	cal, dates, err := calAndDates(fs, calName, args, 1)
+	if err != nil {
+		return table{}, err
+	}
+
+	b := bizcal.NewBounded(cal)
+	if max := 366 * (b.To - b.From + 1); *days > max || *days < -max {
+		return table{}, fmt.Errorf("--days %d outside the supported years %d to %d", *days, b.From, b.To)
+	}
+	return walkResult(b, dates[0], bizcal.AddBusinessDays(b, dates[0], *days))
+}
+
+func dateResult(from, to time.Time) table {
+	return table{
+		cols: []string{"date", "result"},
+		rows: [][]interface{}{{from.Format(dateLayout), to.Format(dateLayout)}},
+	}
+}
+
+func between(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	cal, dates, err := calAndDates(fs, calName, args, 2)
+	if err != nil {
+		return table{}, err
+	}
+
+	return table{
+		cols: []string{"from", "to", "business_days"},
+		rows: [][]interface{}{{
+			dates[0].Format(dateLayout),
+			dates[1].Format(dateLayout),
+			bizcal.BusinessDaysBetween(cal, dates[0], dates[1]),
+		}},
+	}, nil
+}
+
+func holidays(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	year := fs.Int("year", 0, "calendar year")
+	fromStr := fs.String("from", "", "first date, YYYY-MM-DD")
+	toStr := fs.String("to", "", "last date, YYYY-MM-DD")
+	cal, _, err := calAndDates(fs, calName, args, 0)
+	if err != nil {
+		return table{}, err
+	}
+
+	var hs []bizcal.Holiday
+	switch {
+	case *year != 0 && *fromStr == "" && *toStr == "":
+		if err := bizcal.CheckYear(cal, *year); err != nil {
+			return table{}, err
+		}
+		hs = bizcal.HolidaysInYear(cal, *year)
+	case *year == 0 && *fromStr != "" && *toStr != "":
+		from, err := parseDate(*fromStr)
+		if err != nil {
+			return table{}, err
+		}
+		to, err := parseDate(*toStr)
+		if err != nil {
+			return table{}, err
+		}
+		for _, t := range []time.Time{from, to} {
+			if err := bizcal.CheckYear(cal, t.Year()); err != nil {
+				return table{}, err
+			}
+		}
+		hs = bizcal.Holidays(cal, from, to)
+	default:
+		fs.Usage()
+		return table{}, errors.New("holidays takes either --year or both --from and --to")
+	}
+
+	t := table{cols: []string{"date", "name"}, list: true}
+	for _, h := range hs {
+		t.rows = append(t.rows, []interface{}{h.Date.Format(dateLayout), h.Name})
+	}
+
+	return t, nil
+}
+
+func listCalendars(fs *flag.FlagSet, calName *string, args []string) (table, error) {
+	if _, err := parse(fs, args, 0); err != nil {
+		return table{}, err
+	}
+
+	t := table{cols: []string{"name", "aliases"}, list: true}
+	for _, name := range bizcal.CalendarNames() {
+		t.rows = append(t.rows, []interface{}{name, strings.Join(bizcal.CalendarAliases(name), " ")})
+	}
+
+	return t, nil
+}
+
+//write prints a table in the requested format
+func write(w io.Writer, format string, t table) error {
+	switch format {
+	case "text":
+		for _, row := range t.rows {
+			fields := make([]string, len(row))
+			for i, v := range row {
+				fields[i] = fmt.Sprint(v)
+			}
+			fmt.Fprintln(w, strings.TrimRight(strings.Join(fields, "\t"), "\t"))
+		}
+		return nil
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(t.cols)
+		for _, row := range t.rows {
+			fields := make([]string, len(row))
+			for i, v := range row {
+				fields[i] = fmt.Sprint(v)
+			}
+			cw.Write(fields)
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		objs := make([]map[string]interface{}, len(t.rows))
+		for i, row := range t.rows {
+			objs[i] = map[string]interface{}{}
+			for j, v := range row {
+				objs[i][t.cols[j]] = v
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if t.list {
+			return enc.Encode(objs)
+		}
+		return enc.Encode(objs[0])
+	}
+
+	return fmt.Errorf("unknown format %q, want text, json or csv", format)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args string
+		code int
+		out  string
+	}{
+		{"is-business-day --cal XNYS 2024-07-04", 0, "2024-07-04\tfalse\n"},
+		{"is-business-day --cal NYSE 2024-07-05", 0, "2024-07-05\ttrue\n"},
+		{"next --cal XNYS 2024-07-04", 0, "2024-07-04\t2024-07-05\n"},
+		{"prev --cal XNYS 2024-07-04", 0, "2024-07-04\t2024-07-03\n"},
+		{"advance --cal XNYS --days 3 2024-07-03", 0, "2024-07-03\t2024-07-09\n"},
+		{"advance --cal XNYS --days -1 2024-07-05", 0, "2024-07-05\t2024-07-03\n"},
+		{"between --cal XNYS 2024-07-01 2024-07-08", 0, "2024-07-01\t2024-07-08\t4\n"},
+		{"holidays --cal XNYS --from 2024-07-01 --to 2024-07-31", 0, "2024-07-04\tIndependence Day\n"},
+		{"holidays --cal XNYS --year 2024 --format csv", 0, "date,name\n2024-01-01,New Year's Day\n"},
+		{"between --cal XNYS --format json 2024-07-01 2024-07-08", 0, "{\n  \"business_days\": 4,\n  \"from\": \"2024-07-01\",\n  \"to\": \"2024-07-08\"\n}\n"},
+		{"holidays --cal XNYS --format json --from 2024-07-01 --to 2024-07-31", 0, "[\n  {\n    \"date\": \"2024-07-04\",\n    \"name\": \"Independence Day\"\n  }\n]\n"},
+		{"list-calendars --format csv", 0, "name,aliases\n"},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(strings.Fields(tt.args), &stdout, &stderr)
+		if code != tt.code || !strings.HasPrefix(stdout.String(), tt.out) {
+			t.Errorf("bizcal %s = %d %q, want %d %q, stderr %q", tt.args, code, stdout.String(), tt.code, tt.out, stderr.String())
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		args   string
+		code   int
+		stderr string
+	}{
+		// usage
+		{"", 2, "usage: bizcal COMMAND"},
+		{"help", 2, "usage: bizcal COMMAND"},
+		{"weekends --cal XNYS", 2, `unknown command "weekends"`},
+		{"next -h", 2, "usage: bizcal next"},
+		{"next --cal XNYS --bogus 2024-07-04", 2, "flag provided but not defined"},
+		{"advance --cal XNYS --days x 2024-07-04", 2, "invalid value"},
+		// bad input
+		{"next 2024-07-04", 1, "missing --cal"},
+		{"next --cal NOPE 2024-07-04", 1, `unknown calendar "NOPE"`},
+		{"next --cal XNYS 2024-13-01", 1, "want YYYY-MM-DD"},
+		{"next --cal XNYS", 1, "takes 1 argument(s), got 0"},
+		{"between --cal XNYS 2024-07-01", 1, "takes 2 argument(s), got 1"},
+		{"next --cal XNYS --format xml 2024-07-04", 1, `unknown format "xml"`},
+		{"next --cal XNYS 1800-01-02", 1, "outside the supported years"},
+		{"next --cal XSES 2026-12-31", 1, "outside the supported years 2015 to 2026"},
+		{"advance --cal XSES --days 5 2026-12-30", 1, "outside the supported years 2015 to 2026"},
+		{"advance --cal XNYS --days 999999 2024-07-04", 1, "--days 999999 outside the supported years"},
+		{"holidays --cal XNYS", 1, "either --year or both --from and --to"},
+		{"holidays --cal XNYS --year 2024 --from 2024-01-01", 1, "either --year or both --from and --to"},
+		{"holidays --cal XNYS --year 1800", 1, "outside the supported years"},
+		{"holidays --cal XNYS --from 2024-01-01 --to 2024-02-30", 1, "want YYYY-MM-DD"},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(strings.Fields(tt.args), &stdout, &stderr)
+		if code != tt.code || !strings.Contains(stderr.String(), tt.stderr) {
+			t.Errorf("bizcal %s = %d, stderr %q, want %d with %q", tt.args, code, stderr.String(), tt.code, tt.stderr)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("bizcal %s wrote %q to stdout, want nothing", tt.args, stdout.String())
+		}
+	}
+}
@@ -0,0 +1,505 @@
+//Package server serves the registered bizcal calendars over HTTP with JSON
+//
+//	GET  /healthz
+//	GET  /v1/calendars
+//	GET  /v1/calendars/{name}/is-business-day?date=2027-11-26
+//	GET  /v1/calendars/{name}/adjust?date=2027-11-27&convention=following
+//	GET  /v1/calendars/{name}/advance?date=2027-11-26&days=3
+//	GET  /v1/calendars/{name}/between?from=2027-11-01&to=2027-12-01
+//	GET  /v1/calendars/{name}/holidays?year=2027
+//	POST /v1/batch
+//
+//Calendar responses carry an ETag derived from the calendar version,
+//the hash of its holidays, early closes and working weekends,
+//so clients can revalidate with If-None-Match.
+//
+//Dates must fall in the years the calendar supports, see bizcal.YearRange,
+//other dates are answered with 400 Bad Request.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/genghongchen/cal/bizcal"
+)
+
+const dateLayout = "2006-01-02"
+
+//versionFrom and versionTo bound the years hashed into a calendar version
+const (
+	versionFrom = 1950
+	versionTo   = 2099
+)
+
+//MaxBatch is the largest number of queries accepted by one batch request
+const MaxBatch = 10000
+
+//MaxBatchBytes is the largest batch request body read
+const MaxBatchBytes = 4 << 20
+
+//MaxSpanDays is the longest span in days between and holidays walk,
+//and MaxAdvance the most business days advance moves
+const (
+	MaxSpanDays = 36525
+	MaxAdvance  = 25000
+)
+
+//Query is one calendar question, the fields used depend on Op
+type Query struct {
+	Op         string `json:"op"`
+	Calendar   string `json:"calendar"`
+	Date       string `json:"date,omitempty"`
+	Days       int    `json:"days,omitempty"`
+	From       string `json:"from,omitempty"`
+	To         string `json:"to,omitempty"`
+	Year       int    `json:"year,omitempty"`
+	Convention string `json:"convention,omitempty"`
+}
+
+//Result is the answer to one Query
+type Result struct {
+	BusinessDay  *bool     `json:"business_day,omitempty"`
+	Date         string    `json:"date,omitempty"`
+	BusinessDays *int      `json:"business_days,omitempty"`
+	Holidays     []Holiday `json:"holidays,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+//Holiday is one entry of a holiday list
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+//CalendarInfo describes one registered calendar
+type CalendarInfo struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+	Version string   `json:"version"`
+}
+
+//Server is an http.Handler answering calendar queries
+type Server struct {
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	versions map[string]*version
+}
+
+//version is a cached calendar version and the registry revision it was computed for,
+//each calendar is hashed under its own lock
+type version struct {
+	mu  sync.Mutex
+	rev int
+	v   string
+}
+
+//New returns a Server for the calendars registered in bizcal,
+//the calendar versions are computed in the background from the start
+func New() *Server {
+	s := &Server{mux: http.NewServeMux(), versions: map[string]*version{}}
+	s.mux.HandleFunc("/healthz", s.health)
+	s.mux.HandleFunc("/v1/calendars", s.calendars)
+	s.mux.HandleFunc("/v1/calendars/", s.calendar)
+	s.mux.HandleFunc("/v1/batch", s.batch)
+
+	go s.Versions(bizcal.CalendarNames())
+
+	return s
+}
+
+//ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+//Version returns the version of a registered calendar
+//It is a hash of the holidays, early closes, special sessions and working weekends
+//from 1950 to 2099, or the narrower years the calendar supports,
+//computed once per registration of the calendar
+func (s *Server) Version(name string) (string, error) {
+	key, ok := bizcal.CalendarName(name)
+	if !ok {
+		return "", fmt.Errorf("unknown calendar %q", name)
+	}
+	// the revision is read first, a calendar replaced in between
+	// is hashed again on the next call
+	rev := bizcal.CalendarRevision(key)
+	cal, _ := bizcal.Lookup(key)
+
+	s.mu.Lock()
+	ver, ok := s.versions[key]
+	if !ok {
+		ver = &version{}
+		s.versions[key] = ver
+	}
+	s.mu.Unlock()
+
+	ver.mu.Lock()
+	defer ver.mu.Unlock()
+
+	if ver.v != "" && ver.rev == rev {
+		return ver.v, nil
+	}
+
+	fromYear, toYear := bizcal.YearRange(cal)
+	if fromYear < versionFrom {
+		fromYear = versionFrom
+	}
+	if toYear > versionTo {
+		toYear = versionTo
+	}
+	from := time.Date(fromYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(toYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	h := fnv.New64a()
+	for _, hol := range bizcal.Holidays(cal, from, to) {
+		fmt.Fprintf(h, "%s %s\n", hol.Date.Format(dateLayout), hol.Name)
+	}
+	ww, _ := cal.(bizcal.WorkingWeekender)
+	ec, _ := cal.(bizcal.EarlyCloser)
+	ss, _ := cal.(bizcal.SpecialSessioner)
+	for t := from; !t.After(to); t = t.AddDate(0, 0, 1) {
+		if ww != nil && ww.IsWorkingWeekend(t) {
+			fmt.Fprintf(h, "%s working weekend\n", t.Format(dateLayout))
+		}
+		if ec != nil {
+			if c, ok := ec.EarlyClose(t); ok {
+				fmt.Fprintf(h, "%s early close %s\n", t.Format(dateLayout), c.Format(time.RFC3339))
+			}
+		}
+		if ss != nil {
+			if sess, ok := ss.SpecialSession(t); ok {
+				fmt.Fprintf(h, "%s %s %s %s\n", t.Format(dateLayout), sess.Name,
+					sess.Open.Format(time.RFC3339), sess.Close.Format(time.RFC3339))
+			}
+		}
+	}
+	ver.rev, ver.v = rev, strconv.FormatUint(h.Sum64(), 16)
+
+	return ver.v, nil
+}
+
+//Versions returns the versions of registered calendars in the order of names,
+//hashing the calendars not yet cached in parallel
+func (s *Server) Versions(names []string) ([]string, error) {
+	vs := make([]string, len(names))
+	errs := make([]error, len(names))
+
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer func() { <-sem; wg.Done() }()
+			vs[i], errs[i] = s.Version(name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vs, nil
+}
+
+func (s *Server) health(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) calendars(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+
+	names := bizcal.CalendarNames()
+	vs, err := s.Versions(names)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	infos := []CalendarInfo{}
+	for i, name := range names {
+		infos = append(infos, CalendarInfo{Name: name, Aliases: bizcal.CalendarAliases(name), Version: vs[i]})
+	}
+
+	writeJSON(w, http.StatusOK, infos)
+}
+
+//calendar serves /v1/calendars/{name}/{op}
+func (s *Server) calendar(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/calendars/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		writeError(w, http.StatusNotFound, errors.New("want /v1/calendars/{name}/{operation}"))
+		return
+	}
+
+	name, ok := bizcal.CalendarName(parts[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown calendar %q", parts[0]))
+		return
+	}
+	version, err := s.Version(name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	etag := fmt.Sprintf("%q", name+"-"+version)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if noneMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	qv := r.URL.Query()
+	q := Query{
+		Op:         parts[1],
+		Calendar:   parts[0],
+		Date:       qv.Get("date"),
+		From:       qv.Get("from"),
+		To:         qv.Get("to"),
+		Convention: qv.Get("convention"),
+	}
+	if q.Days, err = intParam(qv.Get("days")); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if q.Year, err = intParam(qv.Get("year")); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res := Answer(q)
+	if res.Error != "" {
+		writeJSON(w, http.StatusBadRequest, res)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+//batch answers a JSON array of queries with an array of results in the same order
+func (s *Server) batch(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBatchBytes))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("batch body exceeds %d bytes", MaxBatchBytes))
+		return
+	}
+
+	var qs []Query
+	if err := json.Unmarshal(body, &qs); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid batch: %v", err))
+		return
+	}
+	if len(qs) > MaxBatch {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("batch of %d queries exceeds %d", len(qs), MaxBatch))
+		return
+	}
+
+	res := make([]Result, len(qs))
+	for i, q := range qs {
+		res[i] = Answer(q)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+//Answer evaluates one query against the registered calendars
+//failures are reported in Result.Error
+func Answer(q Query) Result {
+	res, err := answer(q)
+	if err != nil {
+		return Result{Error: err.Error()}
+	}
+
+	return res
+}
+
+func answer(q Query) (Result, error) {
+	cal, ok := bizcal.Lookup(q.Calendar)
+	if !ok {
+		return Result{}, fmt.Errorf("unknown calendar %q", q.Calendar)
+	}
+
+	switch q.Op {
+	case "is-business-day":
+		t, err := parseDate(cal, "date", q.Date)
+		if err != nil {
+			return Result{}, err
+		}
+		b := cal.IsBusinessDay(t)
+		return Result{BusinessDay: &b}, nil
+	case "adjust":
+		t, err := parseDate(cal, "date", q.Date)
+		if err != nil {
+			return Result{}, err
+		}
+		b := bizcal.NewBounded(cal)
+		switch q.Convention {
+		case "", "following":
+			return walkResult(b, bizcal.AdjForBusinessDay(b, t))
+		case "preceding":
+			return walkResult(b, bizcal.AdjLastBusinessDay(b, t))
+		}
+		return Result{}, fmt.Errorf("unknown convention %q, want following or preceding", q.Convention)
+	case "advance":
+		t, err := parseDate(cal, "date", q.Date)
+		if err != nil {
+			return Result{}, err
+		}
+		if q.Days > MaxAdvance || q.Days < -MaxAdvance {
+			return Result{}, fmt.Errorf("days %d exceeds %d", q.Days, MaxAdvance)
+		}
+		b := bizcal.NewBounded(cal)
+		return walkResult(b, bizcal.AddBusinessDays(b, t, q.Days))
+	case "between":
+		from, to, err := parseSpan(cal, q.From, q.To)
+		if err != nil {
+			return Result{}, err
+		}
+		n := bizcal.BusinessDaysBetween(cal, from, to)
+		return Result{BusinessDays: &n}, nil
+	case "holidays":
+		var hs []bizcal.Holiday
+		switch {
+		case q.Year != 0 && q.From == "" && q.To == "":
+			if err := bizcal.CheckYear(cal, q.Year); err != nil {
+				return Result{}, err
+			}
+			hs = bizcal.HolidaysInYear(cal, q.Year)
+		case q.Year == 0 && q.From != "" && q.To != "":
+			from, to, err := parseSpan(cal, q.From, q.To)
+			if err != nil {
+				return Result{}, err
+			}
+			hs = bizcal.Holidays(cal, from, to)
+		default:
+			return Result{}, errors.New("holidays takes either year or both from and to")
+		}
+		res := Result{Holidays: []Holiday{}}
+		for _, h := range hs {
+			res.Holidays = append(res.Holidays, Holiday{Date: h.Date.Format(dateLayout), Name: h.Name})
+		}
+		return res, nil
+	}
+
+	return Result{}, fmt.Errorf("unknown operation %q", q.Op)
+}
+
+//parseDate parses a date in the years a calendar supports
+func parseDate(cal bizcal.BizCal, field, s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("missing %s", field)
+	}
+
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return t, fmt.Errorf("invalid %s %q, want YYYY-MM-DD", field, s)
+	}
+	if err := bizcal.CheckYear(cal, t.Year()); err != nil {
+		return t, fmt.Errorf("invalid %s %q: %v", field, s, err)
+	}
+
+	return t, nil
+}
+
+//parseSpan parses the from and to dates of a span no longer than MaxSpanDays
+func parseSpan(cal bizcal.BizCal, fromS, toS string) (time.Time, time.Time, error) {
+	from, err := parseDate(cal, "from", fromS)
+	if err != nil {
+		return from, from, err
+	}
+	to, err := parseDate(cal, "to", toS)
+	if err != nil {
+		return from, to, err
+	}
+	if days := to.Sub(from).Hours() / 24; days > MaxSpanDays || days < -MaxSpanDays {
+		return from, to, fmt.Errorf("span from %s to %s exceeds %d days", fromS, toS, MaxSpanDays)
+	}
+
+	return from, to, nil
+}
+
+//walkResult is the date a walk ended on, or an error if it left the supported years
+func walkResult(b *bizcal.Bounded, t time.Time) (Result, error) {
+	if err := b.Err(); err != nil {
+		return Result{}, err
+	}
+
+	return Result{Date: t.Format(dateLayout)}, nil
+}
+
+//noneMatch checks an If-None-Match header against an ETag,
+//with weak comparison and lists of tags
+func noneMatch(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intParam(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	return n, nil
+}
+
+func allow(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method || (method == http.MethodGet && r.Method == http.MethodHead) {
+		return true
+	}
+
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Result{Error: err.Error()})
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+//testServer is shared by the tests, New hashes every calendar in the background
+var testServer = New()
+
+func get(t *testing.T, s *Server, path string, header map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func post(t *testing.T, s *Server, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return w
+}
+
+func TestHealth(t *testing.T) {
+	s := testServer
+	w := get(t, s, "/healthz", nil)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"ok"`) {
+		t.Errorf("GET /healthz = %d %s, want 200 ok", w.Code, w.Body)
+	}
+
+	w = post(t, s, "/healthz", "")
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != http.MethodGet {
+		t.Errorf("POST /healthz = %d, Allow %q, want 405 and Allow GET", w.Code, w.Header().Get("Allow"))
+	}
+}
+
+func TestCalendarQueries(t *testing.T) {
+	s := testServer
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/v1/calendars/XNYS/is-business-day?date=2024-07-04", http.StatusOK, `"business_day":false`},
+		{"/v1/calendars/NYSE/is-business-day?date=2024-07-05", http.StatusOK, `"business_day":true`},
+		{"/v1/calendars/XNYS/adjust?date=2024-07-04", http.StatusOK, `"date":"2024-07-05"`},
+		{"/v1/calendars/XNYS/adjust?date=2024-07-04&convention=preceding", http.StatusOK, `"date":"2024-07-03"`},
+		{"/v1/calendars/XNYS/advance?date=2024-07-03&days=1", http.StatusOK, `"date":"2024-07-05"`},
+		{"/v1/calendars/XNYS/between?from=2024-07-01&to=2024-07-08", http.StatusOK, `"business_days":4`},
+		{"/v1/calendars/XNYS/holidays?year=2024", http.StatusOK, `{"date":"2024-07-04","name":"Independence Day"}`},
+		{"/v1/calendars/XNYS/holidays?from=2024-07-01&to=2024-07-31", http.StatusOK, `"Independence Day"`},
+
+		// bad input
+		{"/v1/calendars/XNYS/is-business-day", http.StatusBadRequest, `missing date`},
+		{"/v1/calendars/XNYS/is-business-day?date=2024-13-01", http.StatusBadRequest, `want YYYY-MM-DD`},
+		{"/v1/calendars/XNYS/is-business-day?date=1800-01-01", http.StatusBadRequest, `outside the supported years`},
+		{"/v1/calendars/XSES/is-business-day?date=2030-01-02", http.StatusBadRequest, `outside the supported years`},
+		{"/v1/calendars/XSES/advance?date=2026-12-30&days=5", http.StatusBadRequest, `outside the supported years`},
+		{"/v1/calendars/XNYS/adjust?date=2024-07-04&convention=modified", http.StatusBadRequest, `unknown convention`},
+		{"/v1/calendars/XNYS/advance?date=2024-07-03&days=x", http.StatusBadRequest, `invalid number`},
+		{"/v1/calendars/XNYS/advance?date=2024-07-03&days=99999", http.StatusBadRequest, `exceeds`},
+		{"/v1/calendars/XNYS/between?from=1901-01-01&to=2199-12-31", http.StatusBadRequest, `exceeds`},
+		{"/v1/calendars/XNYS/holidays?year=2024&from=2024-01-01", http.StatusBadRequest, `either year`},
+		{"/v1/calendars/XNYS/weekends?date=2024-07-04", http.StatusBadRequest, `unknown operation`},
+		{"/v1/calendars/NOPE/is-business-day?date=2024-07-04", http.StatusNotFound, `unknown calendar`},
+		{"/v1/calendars/XNYS", http.StatusNotFound, `want /v1/calendars/{name}/{operation}`},
+	}
+
+	for _, tt := range tests {
+		w := get(t, s, tt.path, nil)
+		if w.Code != tt.status || !strings.Contains(w.Body.String(), tt.body) {
+			t.Errorf("GET %s = %d %s, want %d with %s", tt.path, w.Code, strings.TrimSpace(w.Body.String()), tt.status, tt.body)
+		}
+	}
+}
+
+func TestETag(t *testing.T) {
+	s := testServer
+	path := "/v1/calendars/XNYS/is-business-day?date=2024-07-04"
+
+	w := get(t, s, path, nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("GET %s = %d, ETag %q, want 200 with an ETag", path, w.Code, etag)
+	}
+	if alias := get(t, s, "/v1/calendars/NYSE/is-business-day?date=2024-07-04", nil).Header().Get("ETag"); alias != etag {
+		t.Errorf("ETag through the alias = %s, want %s", alias, etag)
+	}
+	if other := get(t, s, "/v1/calendars/XLON/is-business-day?date=2024-07-04", nil).Header().Get("ETag"); other == etag {
+		t.Errorf("XLON and XNYS share the ETag %s", etag)
+	}
+
+	for _, inm := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+		if w := get(t, s, path, map[string]string{"If-None-Match": inm}); w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s = %d %q, want 304 without a body", inm, w.Code, w.Body)
+		}
+	}
+	if w := get(t, s, path, map[string]string{"If-None-Match": `"other"`}); w.Code != http.StatusOK {
+		t.Errorf("If-None-Match \"other\" = %d, want 200", w.Code)
+	}
+
+	// the list of calendars carries the same versions
+	w = get(t, s, "/v1/calendars", nil)
+	var infos []CalendarInfo
+	if err := json.Unmarshal(w.Body.Bytes(), &infos); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("GET /v1/calendars = %d: %v", w.Code, err)
+	}
+	found := false
+	for _, info := range infos {
+		if info.Name == "XNYS" {
+			found = true
+			if want := `"XNYS-` + info.Version + `"`; want != etag {
+				t.Errorf("XNYS version %s does not match the ETag %s", info.Version, etag)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("GET /v1/calendars lacks XNYS")
+	}
+}
+
+func TestBatch(t *testing.T) {
+	s := testServer
+	w := post(t, s, "/v1/batch", `[
+		{"op": "is-business-day", "calendar": "XNYS", "date": "2024-07-04"},
+		{"op": "advance", "calendar": "XNYS", "date": "2024-07-03", "days": 1},
+		{"op": "between", "calendar": "XNYS", "from": "2024-07-01", "to": "2024-07-08"},
+		{"op": "is-business-day", "calendar": "NOPE", "date": "2024-07-04"},
+		{"op": "is-business-day", "calendar": "XNYS", "date": "1800-01-01"}
+	]`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("POST /v1/batch = %d %s, want 200", w.Code, w.Body)
+	}
+
+	var res []Result
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("batch response: %v", err)
+	}
+	if len(res) != 5 {
+		t.Fatalf("batch answered %d queries, want 5", len(res))
+	}
+	if res[0].BusinessDay == nil || *res[0].BusinessDay {
+		t.Errorf("batch result 0 = %+v, want not a business day", res[0])
+	}
+	if res[1].Date != "2024-07-05" {
+		t.Errorf("batch result 1 = %+v, want 2024-07-05", res[1])
+	}
+	if res[2].BusinessDays == nil || *res[2].BusinessDays != 4 {
+		t.Errorf("batch result 2 = %+v, want 4 business days", res[2])
+	}
+	if !strings.Contains(res[3].Error, "unknown calendar") || !strings.Contains(res[4].Error, "outside the supported years") {
+		t.Errorf("batch results 3 and 4 = %+v, %+v, want errors", res[3], res[4])
+	}
+
+	bad := []struct {
+		body   string
+		status int
+	}{
+		{`{"op": "is-business-day"}`, http.StatusBadRequest},
+		{`[`, http.StatusBadRequest},
+		{"[" + strings.Repeat(`{"op":"x"},`, MaxBatch) + `{"op":"x"}]`, http.StatusRequestEntityTooLarge},
+		{`["` + strings.Repeat("x", MaxBatchBytes) + `"]`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range bad {
+		if w := post(t, s, "/v1/batch", tt.body); w.Code != tt.status {
+			t.Errorf("POST /v1/batch of %d bytes = %d, want %d", len(tt.body), w.Code, tt.status)
+		}
+	}
+	if w := get(t, s, "/v1/batch", nil); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET /v1/batch = %d, want 405", w.Code)
+	}
+}
+
+func TestNoneMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{`"a"`, true}, {`W/"a"`, true}, {` "b" , "a" `, true}, {"*", true},
+		{`"b"`, false}, {"", false}, {`"A"`, false},
+	}
+	for _, tt := range tests {
+		if got := noneMatch(tt.header, `"a"`); got != tt.want {
+			t.Errorf("noneMatch(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}